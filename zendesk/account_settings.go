@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AccountSettings is a partial model of the settings of the Zendesk account.
+// Only the most used sections are typed and the others are kept in Extra.
+// Fields are pointers so that UpdateAccountSettings only sends the fields which are set.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+type AccountSettings struct {
+	Branding *AccountBrandingSettings `json:"branding,omitempty"`
+	Tickets  *AccountTicketSettings   `json:"tickets,omitempty"`
+
+	// Extra holds the sections which are not mapped to AccountSettings, such as
+	// "apps" or "routing". It is populated on decode and never sent back to the API.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AccountBrandingSettings is the branding section of AccountSettings
+type AccountBrandingSettings struct {
+	HeaderColor         *string `json:"header_color,omitempty"`
+	PageBackgroundColor *string `json:"page_background_color,omitempty"`
+	TabBackgroundColor  *string `json:"tab_background_color,omitempty"`
+	TextColor           *string `json:"text_color,omitempty"`
+}
+
+// AccountTicketSettings is the tickets section of AccountSettings
+type AccountTicketSettings struct {
+	CommentsPublicByDefault      *bool `json:"comments_public_by_default,omitempty"`
+	IsFirstCommentPrivateEnabled *bool `json:"is_first_comment_private_enabled,omitempty"`
+	ListNewestCommentsFirst      *bool `json:"list_newest_comments_first,omitempty"`
+	Collaboration                *bool `json:"collaboration,omitempty"`
+	PrivateAttachments           *bool `json:"private_attachments,omitempty"`
+	Tagging                      *bool `json:"tagging,omitempty"`
+	AgentCollision               *bool `json:"agent_collision,omitempty"`
+	MarkdownTicketComments       *bool `json:"markdown_ticket_comments,omitempty"`
+	RichTextComments             *bool `json:"rich_text_comments,omitempty"`
+	EmojiAutocompletion          *bool `json:"emoji_autocompletion,omitempty"`
+}
+
+// UnmarshalJSON decodes account settings and keeps unknown sections in Extra
+func (s *AccountSettings) UnmarshalJSON(data []byte) error {
+	type settings AccountSettings
+	var tmp settings
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, tmp)
+	if err != nil {
+		return err
+	}
+
+	*s = AccountSettings(tmp)
+	s.Extra = extra
+	return nil
+}
+
+// AccountSettingsAPI an interface containing all account settings related methods
+type AccountSettingsAPI interface {
+	GetAccountSettings(ctx context.Context) (AccountSettings, error)
+	UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error)
+}
+
+// GetAccountSettings gets the settings of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#show-settings
+func (z *Client) GetAccountSettings(ctx context.Context) (AccountSettings, error) {
+	var result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+
+	body, err := z.get(ctx, "/account/settings.json")
+	if err != nil {
+		return AccountSettings{}, fmt.Errorf("get account settings: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, fmt.Errorf("get account settings: %w", err)
+	}
+	return result.Settings, nil
+}
+
+// UpdateAccountSettings updates the settings of the account which are set in settings
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#update-account-settings
+func (z *Client) UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error) {
+	var data, result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+	data.Settings = settings
+
+	body, err := z.put(ctx, "/account/settings.json", data)
+	if err != nil {
+		return AccountSettings{}, fmt.Errorf("update account settings: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, fmt.Errorf("update account settings: %w", err)
+	}
+	return result.Settings, nil
+}
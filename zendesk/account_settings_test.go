@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetAccountSettings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	settings, err := client.GetAccountSettings(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get account settings: %s", err)
+	}
+
+	if settings.Tickets == nil || settings.Tickets.CommentsPublicByDefault == nil || !*settings.Tickets.CommentsPublicByDefault {
+		t.Fatalf("Unexpected ticket settings %v", settings.Tickets)
+	}
+
+	if settings.Branding == nil || *settings.Branding.HeaderColor != "1A00C3" {
+		t.Fatalf("Unexpected branding settings %v", settings.Branding)
+	}
+
+	if _, ok := settings.Extra["routing"]; !ok {
+		t.Fatalf("Sections which are not typed should be kept in Extra: %v", settings.Extra)
+	}
+	if _, ok := settings.Extra["tickets"]; ok {
+		t.Fatal("Typed sections should not be kept in Extra")
+	}
+}
+
+func TestUpdateAccountSettings(t *testing.T) {
+	var payload map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "account_settings.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	public := false
+	settings, err := client.UpdateAccountSettings(ctx, AccountSettings{
+		Tickets: &AccountTicketSettings{CommentsPublicByDefault: &public},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update account settings: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"tickets": map[string]interface{}{"comments_public_by_default": false},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Fatalf("Only the settings which are set should be sent: %v", payload)
+	}
+
+	if *settings.Tickets.CommentsPublicByDefault {
+		t.Fatal("Returned settings should be updated")
+	}
+}
@@ -43,6 +43,30 @@ const (
 	ActionFieldCommentModeIsPublic
 	// ActionFieldTicketFormID ticket_form_id
 	ActionFieldTicketFormID
+	// ActionFieldBrandID brand_id
+	ActionFieldBrandID
+	// ActionFieldCustomStatusID custom_status_id
+	ActionFieldCustomStatusID
+	// ActionFieldFollower follower
+	ActionFieldFollower
+	// ActionFieldNotificationWebhook notification_webhook
+	ActionFieldNotificationWebhook
+	// ActionFieldNotificationSMSUser notification_sms_user
+	ActionFieldNotificationSMSUser
+	// ActionFieldNotificationSMSGroup notification_sms_group
+	ActionFieldNotificationSMSGroup
+	// ActionFieldNotificationMessagingCSAT notification_messaging_csat
+	ActionFieldNotificationMessagingCSAT
+	// ActionFieldSideConversation side_conversation
+	ActionFieldSideConversation
+	// ActionFieldSideConversationSlack side_conversation_slack
+	ActionFieldSideConversationSlack
+	// ActionFieldSideConversationTicket side_conversation_ticket
+	ActionFieldSideConversationTicket
+	// ActionFieldAddSkills add_skills
+	ActionFieldAddSkills
+	// ActionFieldSetSkills set_skills
+	ActionFieldSetSkills
 )
 
 var actionFieldText = map[int]string{
@@ -66,6 +90,19 @@ var actionFieldText = map[int]string{
 	ActionFieldCommentValueHTML:    "comment_value_html",
 	ActionFieldCommentModeIsPublic: "comment_mode_is_public",
 	ActionFieldTicketFormID:        "ticket_form_id",
+
+	ActionFieldBrandID:                   "brand_id",
+	ActionFieldCustomStatusID:            "custom_status_id",
+	ActionFieldFollower:                  "follower",
+	ActionFieldNotificationWebhook:       "notification_webhook",
+	ActionFieldNotificationSMSUser:       "notification_sms_user",
+	ActionFieldNotificationSMSGroup:      "notification_sms_group",
+	ActionFieldNotificationMessagingCSAT: "notification_messaging_csat",
+	ActionFieldSideConversation:          "side_conversation",
+	ActionFieldSideConversationSlack:     "side_conversation_slack",
+	ActionFieldSideConversationTicket:    "side_conversation_ticket",
+	ActionFieldAddSkills:                 "add_skills",
+	ActionFieldSetSkills:                 "set_skills",
 }
 
 // ActionFieldText takes field type and returns field name string
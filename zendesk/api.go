@@ -1,10 +1,12 @@
 package zendesk
 
 //nolint
-//go:generate  mockgen -destination=mock/client.go -package=mock -mock_names=API=Client github.com/nukosuke/go-zendesk/zendesk API
+//go:generate  mockgen -destination=mock/client.go -package=mock -mock_names=API=Client github.com/lewisje1991/go-zendesk/zendesk API
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	AccountSettingsAPI
+	AppInstallationAPI
 	AutomationAPI
 	AttachmentAPI
 	BaseAPI
@@ -13,20 +15,30 @@ type API interface {
 	DynamicContentAPI
 	GroupAPI
 	GroupMembershipAPI
+	JobStatusAPI
 	LocaleAPI
 	MacroAPI
 	OrganizationAPI
+	OrganizationFieldAPI
 	OrganizationMembershipAPI
+	SatisfactionReasonAPI
+	RoutingAPI
 	SearchAPI
+	SideConversationAPI
 	SLAPolicyAPI
+	SupportAddressAPI
 	TargetAPI
 	TagAPI
 	TicketAuditAPI
 	TicketAPI
+	TicketProblemAPI
 	TicketCommentAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TicketMetricAPI
+	TicketMetricEventAPI
 	TriggerAPI
+	TriggerCategoryAPI
 	UserAPI
 	UserFieldAPI
 	ViewAPI
@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AppInstallation is an installation of a Zendesk app in the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#list-app-installations
+type AppInstallation struct {
+	ID          int64  `json:"id,omitempty"`
+	AppID       int64  `json:"app_id,omitempty"`
+	Product     string `json:"product,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	Collapsible bool   `json:"collapsible,omitempty"`
+
+	// Settings holds the name of the installation and the values of the app's parameters
+	Settings map[string]interface{} `json:"settings,omitempty"`
+
+	RoleRestrictions  []int64    `json:"role_restrictions,omitempty"`
+	GroupRestrictions []int64    `json:"group_restrictions,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// AppRequirement is a resource such as a target or a ticket field which an app installation created
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#list-requirements-for-app-installation
+type AppRequirement struct {
+	AccountID       int64      `json:"account_id"`
+	Identifier      string     `json:"identifier"`
+	RequirementID   int64      `json:"requirement_id"`
+	RequirementType string     `json:"requirement_type"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// AppInstallationAPI an interface containing all app installation related methods
+type AppInstallationAPI interface {
+	GetAppInstallations(ctx context.Context) ([]AppInstallation, error)
+	GetAppInstallation(ctx context.Context, installationID int64) (AppInstallation, error)
+	UpdateAppInstallation(ctx context.Context, installationID int64, installation AppInstallation) (AppInstallation, error)
+	GetAppRequirements(ctx context.Context, installationID int64) ([]AppRequirement, error)
+}
+
+// GetAppInstallations gets all app installations of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#list-app-installations
+func (z *Client) GetAppInstallations(ctx context.Context) ([]AppInstallation, error) {
+	var data struct {
+		Installations []AppInstallation `json:"installations"`
+	}
+
+	body, err := z.get(ctx, "/apps/installations.json")
+	if err != nil {
+		return nil, fmt.Errorf("get app installations: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("get app installations: %w", err)
+	}
+	return data.Installations, nil
+}
+
+// GetAppInstallation gets a specified app installation.
+// Unlike most endpoints, the installation is not wrapped in the response.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#show-app-installation
+func (z *Client) GetAppInstallation(ctx context.Context, installationID int64) (AppInstallation, error) {
+	var result AppInstallation
+
+	body, err := z.get(ctx, fmt.Sprintf("/apps/installations/%d.json", installationID))
+	if err != nil {
+		return AppInstallation{}, fmt.Errorf("get app installation %d: %w", installationID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AppInstallation{}, fmt.Errorf("get app installation %d: %w", installationID, err)
+	}
+	return result, nil
+}
+
+// UpdateAppInstallation updates the settings or the enabled state of an app installation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#update-app-installation
+func (z *Client) UpdateAppInstallation(ctx context.Context, installationID int64, installation AppInstallation) (AppInstallation, error) {
+	var result AppInstallation
+
+	body, err := z.put(ctx, fmt.Sprintf("/apps/installations/%d.json", installationID), installation)
+	if err != nil {
+		return AppInstallation{}, fmt.Errorf("update app installation %d: %w", installationID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AppInstallation{}, fmt.Errorf("update app installation %d: %w", installationID, err)
+	}
+	return result, nil
+}
+
+// GetAppRequirements gets the requirements of a specified app installation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/apps/apps/#list-requirements-for-app-installation
+func (z *Client) GetAppRequirements(ctx context.Context, installationID int64) ([]AppRequirement, error) {
+	var data struct {
+		Requirements []AppRequirement `json:"requirements"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/apps/installations/%d/requirements.json", installationID))
+	if err != nil {
+		return nil, fmt.Errorf("get app requirements %d: %w", installationID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("get app requirements %d: %w", installationID, err)
+	}
+	return data.Requirements, nil
+}
@@ -0,0 +1,88 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetAppInstallations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "app_installations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	installations, err := client.GetAppInstallations(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get app installations: %s", err)
+	}
+
+	if len(installations) != 1 {
+		t.Fatalf("expected length of installations is 1, but got %d", len(installations))
+	}
+
+	if installations[0].Settings["name"] != "Order Lookup" || !*installations[0].Enabled {
+		t.Fatalf("Unexpected installation %v", installations[0])
+	}
+}
+
+func TestGetAppInstallation(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "app_installation.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	installation, err := client.GetAppInstallation(ctx, 42)
+	if err != nil {
+		t.Fatalf("Failed to get app installation: %s", err)
+	}
+
+	if installation.ID != 42 || installation.AppID != 225 {
+		t.Fatalf("Unexpected installation %v", installation)
+	}
+}
+
+func TestUpdateAppInstallation(t *testing.T) {
+	var payload map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("Failed to decode payload: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "app_installation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	installation, err := client.UpdateAppInstallation(ctx, 42, AppInstallation{
+		Settings: map[string]interface{}{"api_url": "https://orders-v2.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update app installation: %s", err)
+	}
+
+	if _, ok := payload["enabled"]; ok {
+		t.Fatalf("enabled should not be sent when it is not set: %v", payload)
+	}
+
+	if installation.Settings["api_url"] != "https://orders-v2.example.com" {
+		t.Fatalf("Unexpected installation %v", installation)
+	}
+}
+
+func TestGetAppRequirements(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "app_requirements.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requirements, err := client.GetAppRequirements(ctx, 42)
+	if err != nil {
+		t.Fatalf("Failed to get app requirements: %s", err)
+	}
+
+	if len(requirements) != 1 || requirements[0].RequirementType != "ticket_fields" {
+		t.Fatalf("Unexpected requirements %v", requirements)
+	}
+}
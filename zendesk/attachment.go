@@ -10,7 +10,8 @@ import (
 	"sync"
 )
 
-// Attachment is struct for attachment payload
+// Attachment is struct for attachment payload. Attachments of images,
+// such as those of ticket comments, have Thumbnails with smaller versions.
 // https://developer.zendesk.com/rest_api/docs/support/attachments.html
 type Attachment struct {
 	ID          int64   `json:"id,omitempty"`
@@ -83,7 +84,7 @@ func (wr *writer) open() error {
 	req.URL.RawQuery = q.Encode()
 
 	go func() {
-		resp, err := wr.httpClient.Do(req)
+		resp, err := wr.do(req)
 		if err != nil {
 			wr.c <- result{
 				err: err,
@@ -160,7 +161,10 @@ type AttachmentAPI interface {
 	GetAttachment(ctx context.Context, id int64) (Attachment, error)
 }
 
-// UploadAttachment returns a writer that can be used to create a zendesk attachment
+// UploadAttachment returns a writer that can be used to create a zendesk attachment.
+// Pass an empty token to start a new upload, or the token of a previous upload
+// to append another file to it. The same token is returned in both cases, so
+// several files can be attached to one comment.
 // ref: https://developer.zendesk.com/rest_api/docs/support/attachments#upload-files
 func (z *Client) UploadAttachment(ctx context.Context, filename string, token string) UploadWriter {
 	return &writer{
@@ -174,7 +178,12 @@ func (z *Client) UploadAttachment(ctx context.Context, filename string, token st
 // DeleteUpload deletes a previously uploaded file
 // ref: https://developer.zendesk.com/rest_api/docs/support/attachments#delete-upload
 func (z *Client) DeleteUpload(ctx context.Context, token string) error {
-	return z.delete(ctx, fmt.Sprintf("/uploads/%s.json", token))
+	err := z.delete(ctx, fmt.Sprintf("/uploads/%s.json", token))
+	if err != nil {
+		return fmt.Errorf("delete upload %s: %w", token, err)
+	}
+
+	return nil
 }
 
 // GetAttachment returns the current state of an uploaded attachment
@@ -186,12 +195,12 @@ func (z *Client) GetAttachment(ctx context.Context, id int64) (Attachment, error
 
 	body, err := z.get(ctx, fmt.Sprintf("/attachments/%d.json", id))
 	if err != nil {
-		return Attachment{}, err
+		return Attachment{}, fmt.Errorf("get attachment %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Attachment{}, err
+		return Attachment{}, fmt.Errorf("get attachment %d: %w", id, err)
 	}
 
 	return result.Attachment, nil
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -50,6 +51,36 @@ func TestWrite(t *testing.T) {
 	}
 }
 
+func TestWriteAppendsToToken(t *testing.T) {
+	file := readFixture(filepath.Join(http.MethodPost, "upload.json"))
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.WriteHeader(http.StatusCreated)
+		w.Write(file)
+	}))
+	defer mockAPI.Close()
+
+	c := newTestClient(mockAPI)
+	w := c.UploadAttachment(ctx, "second.txt", "6bk3gql82em5nmf")
+	if _, err := w.Write([]byte("body")); err != nil {
+		t.Fatalf("Received an error from write %v", err)
+	}
+
+	out, err := w.Close()
+	if err != nil {
+		t.Fatalf("Received an error from close %v", err)
+	}
+
+	if query.Get("token") != "6bk3gql82em5nmf" || query.Get("filename") != "second.txt" {
+		t.Fatalf("Unexpected upload query %v", query)
+	}
+
+	if out.Token != "6bk3gql82em5nmf" {
+		t.Fatalf("Received an unexpected token %s", out.Token)
+	}
+}
+
 func TestWriteCancelledContext(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPost, "ticket.json", 201)
 	defer mockAPI.Close()
@@ -59,6 +59,7 @@ type AutomationAPI interface {
 	GetAutomation(ctx context.Context, id int64) (Automation, error)
 	UpdateAutomation(ctx context.Context, id int64, automation Automation) (Automation, error)
 	DeleteAutomation(ctx context.Context, id int64) error
+	SyncAutomationPositions(ctx context.Context, orderedIDs []int64) error
 }
 
 // GetAutomations fetch automation list
@@ -76,17 +77,17 @@ func (z *Client) GetAutomations(ctx context.Context, opts *AutomationListOptions
 
 	u, err := addOptions("/automations.json", opts)
 	if err != nil {
-		return []Automation{}, Page{}, err
+		return []Automation{}, Page{}, fmt.Errorf("get automations: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []Automation{}, Page{}, err
+		return []Automation{}, Page{}, fmt.Errorf("get automations: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []Automation{}, Page{}, err
+		return []Automation{}, Page{}, fmt.Errorf("get automations: %w", err)
 	}
 
 	return data.Automations, data.Page, nil
@@ -104,12 +105,12 @@ func (z *Client) CreateAutomation(ctx context.Context, automation Automation) (A
 	body, err := z.post(ctx, "/automations.json", data)
 
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("create automation: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("create automation: %w", err)
 	}
 
 	return result.Automation, nil
@@ -125,12 +126,12 @@ func (z *Client) GetAutomation(ctx context.Context, id int64) (Automation, error
 
 	body, err := z.get(ctx, fmt.Sprintf("/automations/%d.json", id))
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("get automation %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("get automation %d: %w", id, err)
 	}
 
 	return result.Automation, nil
@@ -148,12 +149,12 @@ func (z *Client) UpdateAutomation(ctx context.Context, id int64, automation Auto
 	body, err := z.put(ctx, fmt.Sprintf("/automations/%d.json", id), data)
 
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("update automation %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Automation{}, err
+		return Automation{}, fmt.Errorf("update automation %d: %w", id, err)
 	}
 
 	return result.Automation, nil
@@ -165,8 +166,18 @@ func (z *Client) UpdateAutomation(ctx context.Context, id int64, automation Auto
 func (z *Client) DeleteAutomation(ctx context.Context, id int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/automations/%d.json", id))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete automation %d: %w", id, err)
 	}
 
 	return nil
 }
+
+// SyncAutomationPositions sets the order of all automations in one request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/#update-many-automations
+func (z *Client) SyncAutomationPositions(ctx context.Context, orderedIDs []int64) error {
+	if err := z.syncPositions(ctx, "automations", orderedIDs); err != nil {
+		return fmt.Errorf("sync automation positions: %w", err)
+	}
+	return nil
+}
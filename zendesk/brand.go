@@ -45,12 +45,12 @@ func (z *Client) CreateBrand(ctx context.Context, brand Brand) (Brand, error) {
 
 	body, err := z.post(ctx, "/brands.json", data)
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("create brand: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("create brand: %w", err)
 	}
 	return result.Brand, nil
 }
@@ -62,15 +62,15 @@ func (z *Client) GetBrand(ctx context.Context, brandID int64) (Brand, error) {
 		Brand Brand `json:"brand"`
 	}
 
-	body, err := z.get(ctx, fmt.Sprintf("/brands/%d.json", brandID))
+	body, err := z.getCached(ctx, fmt.Sprintf("/brands/%d.json", brandID))
 
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("get brand %d: %w", brandID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("get brand %d: %w", brandID, err)
 	}
 
 	return result.Brand, err
@@ -88,12 +88,12 @@ func (z *Client) UpdateBrand(ctx context.Context, brandID int64, brand Brand) (B
 	body, err := z.put(ctx, fmt.Sprintf("/brands/%d.json", brandID), data)
 
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("update brand %d: %w", brandID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Brand{}, err
+		return Brand{}, fmt.Errorf("update brand %d: %w", brandID, err)
 	}
 
 	return result.Brand, err
@@ -105,7 +105,7 @@ func (z *Client) DeleteBrand(ctx context.Context, brandID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/brands/%d.json", brandID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete brand %d: %w", brandID, err)
 	}
 
 	return nil
@@ -0,0 +1,142 @@
+package zendesk
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// responseCache keeps response bodies of slow-changing resources by path
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+
+	// generation is incremented by every invalidation, so that a response
+	// which was requested before a write is not stored after it
+	generation uint64
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+func (c *responseCache) load(path string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[path]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, path)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// currentGeneration returns the generation to pass to store for a response
+// which is about to be requested
+func (c *responseCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// store keeps body unless the cache was invalidated since generation
+func (c *responseCache) store(path string, body []byte, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	c.entries[path] = cacheEntry{body: body, expires: time.Now().Add(c.ttl)}
+}
+
+// invalidate removes the entries of the resource which path belongs to,
+// e.g. "/ticket_fields/1.json" removes all cached ticket fields
+func (c *responseCache) invalidate(path string) {
+	resource := path
+	if i := strings.IndexAny(strings.TrimPrefix(path, "/"), "/.?"); i >= 0 {
+		resource = path[:i+1]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key := range c.entries {
+		if key == resource || strings.HasPrefix(key, resource+"/") || strings.HasPrefix(key, resource+".") {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// SetCacheTTL caches responses of slow-changing resources for ttl, which are
+// ticket fields, ticket forms, groups including assignable groups, brands and
+// support addresses. Creating, updating or deleting one of them through the client clears the cache of that resource, but changes made
+// elsewhere are seen only after ttl or InvalidateCache. Cached responses are not
+// reported to WithResponse or the hooks, and calls with a context of WithActAs
+// are never cached.
+// A value less than or equal to 0 disables the cache.
+func (z *Client) SetCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		z.cache = nil
+		return
+	}
+	z.cache = newResponseCache(ttl)
+}
+
+// InvalidateCache clears all responses cached by SetCacheTTL and the ticket field IDs
+// remembered by SetCustomFieldByTitle
+func (z *Client) InvalidateCache() {
+	z.forgetTicketFieldIDs()
+	if z.cache == nil {
+		return
+	}
+
+	z.cache.mu.Lock()
+	defer z.cache.mu.Unlock()
+	z.cache.generation++
+	z.cache.entries = make(map[string]cacheEntry)
+}
+
+// getCached is get for slow-changing resources, which consults the cache first.
+// Requests on behalf of another user bypass the cache, as what they see depends
+// on that user. Resources read with it are listed in the doc of SetCacheTTL.
+func (z *Client) getCached(ctx context.Context, path string) ([]byte, error) {
+	if z.cache == nil || actingAs(ctx) {
+		return z.get(ctx, path)
+	}
+
+	if body, ok := z.cache.load(path); ok {
+		return body, nil
+	}
+
+	generation := z.cache.currentGeneration()
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	z.cache.store(path, body, generation)
+	return body, nil
+}
+
+// invalidateCache clears the cache of the resource which a successful write to path changed
+// A write skipped by SetDryRun changed nothing, so the cache is kept.
+func (z *Client) invalidateCache(path string) {
+	if z.dryRun != nil {
+		return
+	}
+	if strings.HasPrefix(path, "/ticket_fields") {
+		z.forgetTicketFieldIDs()
+	}
+	if z.cache != nil {
+		z.cache.invalidate(path)
+	}
+}
@@ -0,0 +1,181 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newCountingMockAPI(count *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*count++
+		switch r.Method {
+		case http.MethodGet:
+			w.Write(readFixture(filepath.Join(http.MethodGet, "group.json")))
+		case http.MethodPut:
+			w.Write(readFixture(filepath.Join(http.MethodPut, "groups.json")))
+		}
+	}))
+}
+
+func TestCache(t *testing.T) {
+	var count int
+	mockAPI := newCountingMockAPI(&count)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetGroup(ctx, 123); err != nil {
+			t.Fatalf("Failed to get group: %s", err)
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 request, but got %d", count)
+	}
+
+	client.InvalidateCache()
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 requests after InvalidateCache, but got %d", count)
+	}
+
+	if _, err := client.UpdateGroup(ctx, 123, Group{}); err != nil {
+		t.Fatalf("Failed to update group: %s", err)
+	}
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 4 {
+		t.Fatalf("expected 4 requests after UpdateGroup, but got %d", count)
+	}
+}
+
+func TestCacheExpires(t *testing.T) {
+	var count int
+	mockAPI := newCountingMockAPI(&count)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Millisecond)
+
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 requests, but got %d", count)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	var count int
+	mockAPI := newCountingMockAPI(&count)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Minute)
+	client.SetCacheTTL(0)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetGroup(ctx, 123); err != nil {
+			t.Fatalf("Failed to get group: %s", err)
+		}
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 requests, but got %d", count)
+	}
+}
+
+func TestResponseCacheInvalidate(t *testing.T) {
+	c := newResponseCache(time.Minute)
+	for _, path := range []string{"/groups.json", "/groups/1.json", "/groups/assignable.json", "/group_memberships.json", "/brands/1.json"} {
+		c.store(path, []byte("{}"), c.currentGeneration())
+	}
+
+	c.invalidate("/groups/1.json")
+
+	for path, cached := range map[string]bool{
+		"/groups.json":            false,
+		"/groups/1.json":          false,
+		"/groups/assignable.json": false,
+		"/group_memberships.json": true,
+		"/brands/1.json":          true,
+	} {
+		if _, ok := c.load(path); ok != cached {
+			t.Fatalf("expected %s cached to be %v", path, cached)
+		}
+	}
+}
+
+func TestResponseCacheStoreAfterInvalidate(t *testing.T) {
+	c := newResponseCache(time.Minute)
+
+	// a read which started before a write must not store its old body after it
+	generation := c.currentGeneration()
+	c.invalidate("/groups/1.json")
+	c.store("/groups/1.json", []byte("{}"), generation)
+
+	if _, ok := c.load("/groups/1.json"); ok {
+		t.Fatal("a body read before the invalidation should not be cached")
+	}
+}
+
+func TestCacheSkippedForActAs(t *testing.T) {
+	var count int
+	mockAPI := newCountingMockAPI(&count)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Minute)
+
+	actAsCtx := WithActAs(ctx, "customer@example.com")
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetGroup(actAsCtx, 123); err != nil {
+			t.Fatalf("Failed to get group: %s", err)
+		}
+	}
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 3 {
+		t.Fatalf("expected requests on behalf of a user to bypass the cache, but got %d requests", count)
+	}
+}
+
+func TestCacheKeptOnFailedWrite(t *testing.T) {
+	var count int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "group.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Minute)
+
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if _, err := client.UpdateGroup(ctx, 123, Group{}); err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected a failed write to keep the cache, but got %d requests", count)
+	}
+}
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// readGroup shares one in-flight GET request between concurrent callers of the same path
+type readGroup struct {
+	mu    sync.Mutex
+	calls map[string]*readCall
+}
+
+type readCall struct {
+	done      chan struct{}
+	body      []byte
+	err       error
+	followers int
+}
+
+// errSharedReadPanicked is returned to the callers waiting for a request whose caller panicked
+var errSharedReadPanicked = errors.New("shared request panicked")
+
+// do calls fn, or waits for the call of another caller with the same key in flight
+// until it completes or ctx is done. shared reports whether the result came from
+// another caller. Waiting callers are released even when fn panics.
+func (g *readGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) (body []byte, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*readCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		call.followers++
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.body, call.err, true
+		case <-ctx.Done():
+			return nil, ctx.Err(), true
+		}
+	}
+
+	call := &readCall{done: make(chan struct{}), err: errSharedReadPanicked}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.body, call.err = fn()
+	return call.body, call.err, false
+}
+
+// SetCoalesceReads makes concurrent identical GET requests, such as GetMacro
+// of the same macro from several goroutines, share one request to Zendesk.
+// The shared request is sent with the context of the first caller. When that
+// context is canceled, the other callers send their own request, and a caller
+// whose own context is done stops waiting.
+// Calls with a context of WithResponse or WithActAs are never shared.
+func (z *Client) SetCoalesceReads(enabled bool) {
+	if !enabled {
+		z.reads = nil
+		return
+	}
+	z.reads = &readGroup{}
+}
+
+// coalescable reports whether a GET request with ctx can be shared with other callers
+func coalescable(ctx context.Context) bool {
+	if resp, ok := ctx.Value(responseKey{}).(*Response); ok && resp != nil {
+		return false
+	}
+	return !actingAs(ctx)
+}
+
+// getShared is get which shares the request with concurrent callers of the same path
+func (z *Client) getShared(ctx context.Context, path string) ([]byte, error) {
+	body, err, shared := z.reads.do(ctx, path, func() ([]byte, error) {
+		return z.getOnce(ctx, path)
+	})
+
+	canceled := errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+	if shared && canceled && ctx.Err() == nil {
+		return z.getOnce(ctx, path)
+	}
+	return body, err
+}
@@ -0,0 +1,243 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBlockingMacroMockAPI serves a macro once release is closed. Each request
+// is reported to arrived and gives up when its client cancels it.
+func newBlockingMacroMockAPI(requests *int32, arrived chan<- struct{}, release <-chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		select {
+		case arrived <- struct{}{}:
+		default:
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro.json")))
+	}))
+}
+
+// waitForFollowers waits until n callers wait for the in-flight call of key
+func waitForFollowers(t *testing.T, g *readGroup, key string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		g.mu.Lock()
+		call := g.calls[key]
+		joined := call != nil && call.followers >= n
+		g.mu.Unlock()
+
+		if joined {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d followers of %s", n, key)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCoalesceReads(t *testing.T) {
+	var requests int32
+	arrived, release := make(chan struct{}, 5), make(chan struct{})
+	mockAPI := newBlockingMacroMockAPI(&requests, arrived, release)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCoalesceReads(true)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	getMacro := func() {
+		defer wg.Done()
+		macro, err := client.GetMacro(ctx, 2)
+		if err == nil && macro.ID != 360111062754 {
+			t.Errorf("unexpected macro %d", macro.ID)
+		}
+		errs <- err
+	}
+
+	wg.Add(1)
+	go getMacro()
+	<-arrived
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go getMacro()
+	}
+	waitForFollowers(t, client.reads, "/macros/2.json", 4)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Failed to get macro: %s", err)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, but got %d", requests)
+	}
+
+	if _, err := client.GetMacro(ctx, 2); err != nil {
+		t.Fatalf("Failed to get macro: %s", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected a new request once the first completed, but got %d requests", requests)
+	}
+}
+
+func TestCoalesceReadsDisabled(t *testing.T) {
+	var requests int32
+	arrived, release := make(chan struct{}, 5), make(chan struct{})
+	mockAPI := newBlockingMacroMockAPI(&requests, arrived, release)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetMacro(ctx, 2); err != nil {
+				t.Errorf("Failed to get macro: %s", err)
+			}
+		}()
+	}
+
+	// all requests are in flight at once, so they would be shared if enabled
+	for i := 0; i < 3; i++ {
+		<-arrived
+	}
+	close(release)
+	wg.Wait()
+
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, but got %d", requests)
+	}
+}
+
+func TestCoalesceReadsCanceledLeader(t *testing.T) {
+	var requests int32
+	arrived, release := make(chan struct{}, 5), make(chan struct{})
+	mockAPI := newBlockingMacroMockAPI(&requests, arrived, release)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCoalesceReads(true)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		client.GetMacro(leaderCtx, 2)
+	}()
+	<-arrived
+
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetMacro(ctx, 2)
+		followerErr <- err
+	}()
+	waitForFollowers(t, client.reads, "/macros/2.json", 1)
+
+	cancel()
+	wg.Wait()
+	close(release)
+
+	if err := <-followerErr; err != nil {
+		t.Fatalf("expected follower to send its own request, but got %s", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, but got %d", requests)
+	}
+}
+
+func TestCoalesceReadsCanceledFollower(t *testing.T) {
+	var requests int32
+	arrived, release := make(chan struct{}, 5), make(chan struct{})
+	mockAPI := newBlockingMacroMockAPI(&requests, arrived, release)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCoalesceReads(true)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		client.GetMacro(ctx, 2)
+	}()
+	<-arrived
+
+	followerCtx, cancel := context.WithCancel(ctx)
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetMacro(followerCtx, 2)
+		followerErr <- err
+	}()
+	waitForFollowers(t, client.reads, "/macros/2.json", 1)
+	cancel()
+
+	// the leader is still waiting for release, so the follower returns on its own
+	if err := <-followerErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected follower to stop when canceled, but got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+	if requests != 1 {
+		t.Fatalf("expected 1 request, but got %d", requests)
+	}
+}
+
+func TestReadGroupPanic(t *testing.T) {
+	g := &readGroup{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		defer func() { recover() }()
+		g.do(ctx, "key", func() ([]byte, error) {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+
+	<-started
+	result := make(chan error)
+	go func() {
+		_, err, shared := g.do(ctx, "key", func() ([]byte, error) { return nil, nil })
+		if !shared {
+			t.Errorf("expected the follower to wait for the leader")
+		}
+		result <- err
+	}()
+
+	waitForFollowers(t, g, "key", 1)
+	close(release)
+
+	if err := <-result; !errors.Is(err, errSharedReadPanicked) {
+		t.Fatalf("expected errSharedReadPanicked, but got %v", err)
+	}
+
+	if _, err, shared := g.do(ctx, "key", func() ([]byte, error) { return []byte("ok"), nil }); err != nil || shared {
+		t.Fatalf("expected a new call after the panic, but got %v shared %t", err, shared)
+	}
+}
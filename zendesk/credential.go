@@ -30,6 +30,17 @@ func (c BasicAuthCredential) Secret() string {
 	return c.password
 }
 
+// String returns the email address with the password redacted, so that
+// printing the credential doesn't leak it
+func (c BasicAuthCredential) String() string {
+	return c.email + ":" + redacted
+}
+
+// GoString is String for the %#v verb
+func (c BasicAuthCredential) GoString() string {
+	return c.String()
+}
+
 // APITokenCredential is type of credential for API token authentication
 type APITokenCredential struct {
 	email    string
@@ -53,3 +64,14 @@ func (c APITokenCredential) Email() string {
 func (c APITokenCredential) Secret() string {
 	return c.apiToken
 }
+
+// String returns the email address with the API token redacted, so that
+// printing the credential doesn't leak it
+func (c APITokenCredential) String() string {
+	return c.Email() + ":" + redacted
+}
+
+// GoString is String for the %#v verb
+func (c APITokenCredential) GoString() string {
+	return c.String()
+}
@@ -1,6 +1,10 @@
 package zendesk
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestNewBasicAuthCredential(t *testing.T) {
 	cred := NewBasicAuthCredential("john.doe@example.com", "password")
@@ -23,3 +27,18 @@ func TestNewAPITokenCredential(t *testing.T) {
 		t.Fatalf("APITokenCredential: secret not match")
 	}
 }
+
+func TestCredentialString(t *testing.T) {
+	creds := []Credential{
+		NewBasicAuthCredential("john.doe@example.com", "password"),
+		NewAPITokenCredential("john.doe@example.com", "apitoken"),
+	}
+
+	for _, cred := range creds {
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			if s := fmt.Sprintf(format, cred); strings.Contains(s, cred.Secret()) {
+				t.Fatalf("%s of the credential contains the secret: %s", format, s)
+			}
+		}
+	}
+}
@@ -16,3 +16,42 @@ type CursorOption struct {
 	StartTime int64  `url:"start_time,omitempty"`
 	Cursor    string `url:"cursor,omitempty"`
 }
+
+// CursorPagination is the meta of a page of the newer cursor-based pagination
+//
+// ref: https://developer.zendesk.com/api-reference/introduction/pagination/#using-cursor-pagination
+type CursorPagination struct {
+	HasMore      bool   `json:"has_more"`
+	AfterCursor  string `json:"after_cursor"`
+	BeforeCursor string `json:"before_cursor"`
+}
+
+// CursorPaginationOptions is options for list methods with cursor-based pagination.
+// Set After to CursorPagination.AfterCursor of a page to get the next page.
+type CursorPaginationOptions struct {
+	PageSize int    `url:"page[size],omitempty"`
+	After    string `url:"page[after],omitempty"`
+	Before   string `url:"page[before],omitempty"`
+}
+
+// IncrementalPage is the pagination of time-based incremental exports.
+// Pass EndTime as the start time of the next request until EndOfStream is true.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#time-based-incremental-exports
+type IncrementalPage struct {
+	NextPage    string `json:"next_page"`
+	Count       int    `json:"count"`
+	EndTime     int64  `json:"end_time"`
+	EndOfStream bool   `json:"end_of_stream"`
+}
+
+// IncrementalCursor is the pagination of cursor-based incremental exports.
+// Pass AfterCursor as the cursor of the next request. When EndOfStream is true,
+// the export has caught up and AfterCursor is where to resume it later.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#cursor-based-incremental-exports
+type IncrementalCursor struct {
+	AfterURL    string `json:"after_url"`
+	AfterCursor string `json:"after_cursor"`
+	EndOfStream bool   `json:"end_of_stream"`
+}
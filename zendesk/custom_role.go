@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -38,12 +39,12 @@ func (z *Client) GetCustomRoles(ctx context.Context) ([]CustomRole, error) {
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get custom roles: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get custom roles: %w", err)
 	}
 	return data.CustomRoles, nil
 }
@@ -0,0 +1,115 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUserNotDeleted is returned by PermanentlyDeleteUser for a user who was not
+// deleted with DeleteUser first
+var ErrUserNotDeleted = errors.New("zendesk: user must be deleted before it is permanently deleted")
+
+// DeleteUser deletes the specified user. The user stays in GetDeletedUsers,
+// with most of their data, until PermanentlyDeleteUser is called.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.deleteWithBody(ctx, fmt.Sprintf("/users/%d.json", userID))
+	if err != nil {
+		return User{}, fmt.Errorf("delete user %d: %w", userID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, fmt.Errorf("delete user %d: %w", userID, err)
+	}
+	return result.User, nil
+}
+
+// GetDeletedUsers gets the users who were deleted but not permanently deleted yet
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+func (z *Client) GetDeletedUsers(ctx context.Context, opts *PageOptions) ([]User, Page, error) {
+	var data struct {
+		DeletedUsers []User `json:"deleted_users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/deleted_users.json", tmp)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get deleted users: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get deleted users: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get deleted users: %w", err)
+	}
+	return data.DeletedUsers, data.Page, nil
+}
+
+// GetDeletedUser gets the specified deleted user. It returns ErrNotFound for a user
+// who was not deleted or was permanently deleted already.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-deleted-user
+func (z *Client) GetDeletedUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		DeletedUser User `json:"deleted_user"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, fmt.Errorf("get deleted user %d: %w", userID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, fmt.Errorf("get deleted user %d: %w", userID, err)
+	}
+	return result.DeletedUser, nil
+}
+
+// PermanentlyDeleteUser erases a deleted user and their personal data, e.g. for
+// a right to erasure request. The user must be deleted with DeleteUser first,
+// which is checked before the request so that ErrUserNotDeleted is returned
+// instead of the API's not found error. This can't be undone.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		DeletedUser User `json:"deleted_user"`
+	}
+
+	if _, err := z.GetDeletedUser(ctx, userID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			err = ErrUserNotDeleted
+		}
+		return User{}, fmt.Errorf("permanently delete user %d: %w", userID, err)
+	}
+
+	body, err := z.deleteWithBody(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, fmt.Errorf("permanently delete user %d: %w", userID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, fmt.Errorf("permanently delete user %d: %w", userID, err)
+	}
+	return result.DeletedUser, nil
+}
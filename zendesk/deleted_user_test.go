@@ -0,0 +1,76 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/369531345753.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`{"user": {"id": 369531345753, "name": "Sample customer", "active": false}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to delete user: %s", err)
+	}
+
+	if user.ID != 369531345753 || user.Active {
+		t.Fatalf("unexpected deleted user %+v", user)
+	}
+}
+
+func TestGetDeletedUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, page, err := client.GetDeletedUsers(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get deleted users: %s", err)
+	}
+
+	if len(users) != 1 || users[0].ID != 369531345753 || page.Count != 1 {
+		t.Fatalf("unexpected deleted users %v %+v", users, page)
+	}
+}
+
+func TestPermanentlyDeleteUser(t *testing.T) {
+	var deleted bool
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/deleted_users/369531345753.json":
+			w.Write([]byte(`{"deleted_user": {"id": 369531345753, "active": false}}`))
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "RecordNotFound"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/deleted_users/369531345753.json":
+			deleted = true
+			w.Write([]byte(`{"deleted_user": {"id": 369531345753, "active": false}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.PermanentlyDeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to permanently delete user: %s", err)
+	}
+	if !deleted || user.ID != 369531345753 {
+		t.Fatalf("expected the user to be permanently deleted, but got %+v", user)
+	}
+
+	_, err = client.PermanentlyDeleteUser(ctx, 2)
+	if !errors.Is(err, ErrUserNotDeleted) {
+		t.Fatalf("expected ErrUserNotDeleted for a user who was not deleted, but got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// dryRun synthesizes the responses of mutating requests when SetDryRun is enabled
+type dryRun struct {
+	mu     sync.Mutex
+	lastID int64
+}
+
+// deleteWithBodyKey marks the requests of deleteWithBody, whose dry run
+// response must have a body like the real one
+type deleteWithBodyKey struct{}
+
+// SetDryRun makes the client skip POST, PUT, PATCH and DELETE requests, e.g. to
+// check a deployment script against a real account. Such requests are logged
+// as LogDryRun instead of being sent, and get a response which echoes the payload:
+// CreateMacro returns the macro it was passed, with a negative fake ID, and
+// UpdateMacro the macro unchanged. Methods whose response is not their payload,
+// such as UpdateManyTickets, return zero values. GET requests are still sent,
+// and the cache of SetCacheTTL is kept as nothing changed.
+func (z *Client) SetDryRun(enabled bool) {
+	if !enabled {
+		z.dryRun = nil
+		return
+	}
+	z.dryRun = &dryRun{}
+}
+
+// skips reports whether req is not sent in a dry run
+func (d *dryRun) skips(req *http.Request) bool {
+	return d != nil && req.Method != http.MethodGet && req.Method != http.MethodHead
+}
+
+// respond reads the body of req, logs the request and returns a response
+// which is accepted by the method which sent it
+func (d *dryRun) respond(z *Client, req *http.Request) *http.Response {
+	var payload []byte
+	if req.Body != nil {
+		payload, _ = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+	}
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	if json.Valid(payload) {
+		fields["body"] = string(payload)
+	} else {
+		fields["size"] = len(payload)
+	}
+	z.log(req.Context(), LogDryRun, fields)
+
+	status, body := http.StatusOK, d.echo(req.Method, payload)
+	switch {
+	case req.Method == http.MethodPost:
+		status = http.StatusCreated
+	case req.Method == http.MethodDelete && req.Context().Value(deleteWithBodyKey{}) == nil:
+		status, body = http.StatusNoContent, nil
+	}
+	return &http.Response{
+		Status:     http.StatusText(status),
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// echo returns payload as the response body. The objects it wraps, such as
+// the macro of {"macro": {...}}, get a fake ID when they are created.
+func (d *dryRun) echo(method string, payload []byte) []byte {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return []byte("{}")
+	}
+	if method != http.MethodPost {
+		return payload
+	}
+
+	for key, value := range data {
+		if !strings.HasPrefix(string(bytes.TrimSpace(value)), "{") {
+			continue
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal(value, &object); err != nil {
+			continue
+		}
+		if _, ok := object["id"]; ok {
+			continue
+		}
+		object["id"] = d.nextID()
+
+		if out, err := json.Marshal(object); err == nil {
+			data[key] = out
+		}
+	}
+
+	out, err := json.Marshal(data)
+	if err != nil {
+		return []byte("{}")
+	}
+	return out
+}
+
+// nextID returns a fake ID which can't be the ID of an existing resource
+func (d *dryRun) nextID() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lastID--
+	return d.lastID
+}
@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDryRun(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request in a dry run: %s", r.Method, r.URL)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var events []map[string]interface{}
+	client.SetLogger(LoggerFunc(func(ctx context.Context, msg string, fields map[string]interface{}) {
+		if msg == LogDryRun {
+			events = append(events, fields)
+		}
+	}))
+	client.SetDryRun(true)
+
+	created, err := client.CreateMacro(ctx, Macro{Title: "Close and redirect", Actions: []MacroAction{{Field: "status", Value: "solved"}}})
+	if err != nil {
+		t.Fatalf("Failed to create macro in a dry run: %s", err)
+	}
+	if created.ID != -1 || created.Title != "Close and redirect" || len(created.Actions) != 1 {
+		t.Fatalf("expected the macro to be echoed with a fake ID, but got %+v", created)
+	}
+
+	second, err := client.CreateMacro(ctx, Macro{Title: "Escalate"})
+	if err != nil || second.ID != -2 {
+		t.Fatalf("expected another fake ID, but got %d %v", second.ID, err)
+	}
+
+	updated, err := client.UpdateMacro(ctx, 360111062754, Macro{Title: "Renamed"})
+	if err != nil || updated.Title != "Renamed" {
+		t.Fatalf("expected the update to be echoed, but got %+v %v", updated, err)
+	}
+
+	if err := client.DeleteMacro(ctx, 360111062754); err != nil {
+		t.Fatalf("Failed to delete macro in a dry run: %s", err)
+	}
+
+	upload := client.UploadAttachment(ctx, "log.txt", "")
+	upload.Write([]byte("data"))
+	if _, err := upload.Close(); err != nil {
+		t.Fatalf("Failed to upload in a dry run: %s", err)
+	}
+
+	if _, err := client.GetMacro(ctx, 360111062754); err != nil {
+		t.Fatalf("GET requests should still be sent in a dry run: %s", err)
+	}
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil || user.ID != 0 {
+		t.Fatalf("expected a zero user from a dry run delete, but got %+v %v", user, err)
+	}
+
+	if len(events) != 6 {
+		t.Fatalf("expected 6 dry run events, but got %v", events)
+	}
+	if events[0]["method"] != http.MethodPost || events[0]["path"] != "/macros.json" || !strings.Contains(events[0]["body"].(string), "Close and redirect") {
+		t.Fatalf("unexpected dry run event %v", events[0])
+	}
+	if events[4]["size"] != 4 {
+		t.Fatalf("expected the size of the upload, but got %v", events[4])
+	}
+
+	client.SetDryRun(false)
+	if client.dryRun != nil {
+		t.Fatal("dry run should be disabled")
+	}
+}
+
+func TestDryRunKeepsCache(t *testing.T) {
+	var count int
+	mockAPI := newCountingMockAPI(&count)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCacheTTL(time.Minute)
+	client.SetDryRun(true)
+
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if _, err := client.UpdateGroup(ctx, 123, Group{Name: "Renamed"}); err != nil {
+		t.Fatalf("Failed to update group in a dry run: %s", err)
+	}
+	if err := client.DeleteGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to delete group in a dry run: %s", err)
+	}
+	if _, err := client.GetGroup(ctx, 123); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected the cached group to be kept in a dry run, but got %d requests", count)
+	}
+}
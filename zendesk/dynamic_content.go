@@ -57,12 +57,12 @@ func (z *Client) GetDynamicContentItems(ctx context.Context) ([]DynamicContentIt
 
 	body, err := z.get(ctx, "/dynamic_content/items.json")
 	if err != nil {
-		return []DynamicContentItem{}, Page{}, err
+		return []DynamicContentItem{}, Page{}, fmt.Errorf("get dynamic content items: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []DynamicContentItem{}, Page{}, err
+		return []DynamicContentItem{}, Page{}, fmt.Errorf("get dynamic content items: %w", err)
 	}
 	return data.Items, data.Page, nil
 }
@@ -78,12 +78,12 @@ func (z *Client) CreateDynamicContentItem(ctx context.Context, item DynamicConte
 
 	body, err := z.post(ctx, "/dynamic_content/items.json", data)
 	if err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("create dynamic content item: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("create dynamic content item: %w", err)
 	}
 	return result.Item, nil
 }
@@ -98,11 +98,11 @@ func (z *Client) GetDynamicContentItem(ctx context.Context, id int64) (DynamicCo
 
 	body, err := z.get(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", id))
 	if err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("get dynamic content item %d: %w", id, err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("get dynamic content item %d: %w", id, err)
 	}
 
 	return result.Item, nil
@@ -119,11 +119,11 @@ func (z *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item Dy
 
 	body, err := z.put(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", id), data)
 	if err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("update dynamic content item %d: %w", id, err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return DynamicContentItem{}, err
+		return DynamicContentItem{}, fmt.Errorf("update dynamic content item %d: %w", id, err)
 	}
 
 	return result.Item, nil
@@ -135,7 +135,7 @@ func (z *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item Dy
 func (z *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", id))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete dynamic content item %d: %w", id, err)
 	}
 
 	return nil
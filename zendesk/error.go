@@ -2,10 +2,20 @@ package zendesk
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+)
+
+// Sentinel errors which can be checked with errors.Is. An Error returned for
+// a response with the matching status code is reported as one of them.
+var (
+	ErrNotFound     = errors.New("zendesk: not found")
+	ErrRateLimited  = errors.New("zendesk: rate limited")
+	ErrUnauthorized = errors.New("zendesk: unauthorized")
 )
 
 // Error an error type containing the http response from zendesk
@@ -14,9 +24,10 @@ type Error struct {
 	resp *http.Response
 }
 
-// Error the error string for this error
+// Error the error string for this error. Credentials of the request are
+// redacted in case the response body echoes them.
 func (e Error) Error() string {
-	msg := string(e.body)
+	msg := redactCredentials(string(e.body), e.resp.Request)
 	if msg == "" {
 		msg = http.StatusText(e.Status())
 	}
@@ -39,6 +50,47 @@ func (e Error) Status() int {
 	return e.resp.StatusCode
 }
 
+// Is reports whether the status code of e matches the sentinel error target
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status() == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Status() == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.Status() == http.StatusUnauthorized
+	}
+	return false
+}
+
+// redacted replaces credentials in error messages
+const redacted = "[REDACTED]"
+
+// redactCredentials replaces the Authorization header of req, its encoded
+// credentials and the password or API token in msg
+func redactCredentials(msg string, req *http.Request) string {
+	if req == nil {
+		return msg
+	}
+	auth := req.Header.Get("Authorization")
+	if auth == "" {
+		return msg
+	}
+
+	secrets := []string{auth}
+	if i := strings.IndexByte(auth, ' '); i >= 0 {
+		secrets = append(secrets, auth[i+1:])
+	}
+	if _, password, ok := req.BasicAuth(); ok && password != "" {
+		secrets = append(secrets, password)
+	}
+
+	for _, secret := range secrets {
+		msg = strings.ReplaceAll(msg, secret, redacted)
+	}
+	return msg
+}
+
 // OptionsError is an error type for invalid option argument.
 type OptionsError struct {
 	opts interface{}
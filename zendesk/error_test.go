@@ -1,8 +1,12 @@
 package zendesk
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -73,3 +77,81 @@ func TestError_Status(t *testing.T) {
 		t.Fatal("Status returned from error was not the correct status code")
 	}
 }
+
+func TestError_Is(t *testing.T) {
+	cases := []struct {
+		status   int
+		sentinel error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusUnauthorized, ErrUnauthorized},
+	}
+
+	for _, c := range cases {
+		err := Error{resp: &http.Response{StatusCode: c.status}}
+		if !errors.Is(err, c.sentinel) {
+			t.Fatalf("expected %d to be %v", c.status, c.sentinel)
+		}
+	}
+
+	err := Error{resp: &http.Response{StatusCode: http.StatusInternalServerError}}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUnauthorized) {
+		t.Fatal("500 should not match any sentinel error")
+	}
+}
+
+func TestWrappedError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "macro.json", http.StatusNotFound)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetMacro(ctx, 2)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !strings.HasPrefix(err.Error(), "get macro 2: ") {
+		t.Fatalf("error message does not describe the call: %s", err)
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected wrapped error to be ErrNotFound: %v", err)
+	}
+
+	var zerr Error
+	if !errors.As(err, &zerr) {
+		t.Fatalf("expected wrapped error to be Error: %v", err)
+	}
+
+	if zerr.Status() != http.StatusNotFound {
+		t.Fatalf("unexpected status %d", zerr.Status())
+	}
+}
+
+func TestError_ErrorRedactsCredentials(t *testing.T) {
+	const token = "s3cr3t-api-token"
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, password, _ := r.BasicAuth()
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprintf(w, `{"error": "Couldn't authenticate you", "authorization": %q, "token": %q}`, r.Header.Get("Authorization"), password)
+	}))
+	client := newTestClient(mockAPI)
+	client.SetCredential(NewAPITokenCredential("agent@example.com", token))
+	defer mockAPI.Close()
+
+	_, err := client.GetTicket(ctx, 2)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, but got %v", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString([]byte("agent@example.com/token:" + token))
+	for _, secret := range []string{token, encoded} {
+		if strings.Contains(err.Error(), secret) {
+			t.Fatalf("error contains a secret: %s", err)
+		}
+	}
+	if !strings.Contains(err.Error(), "Couldn't authenticate you") {
+		t.Fatalf("expected the rest of the body in the error, but got %s", err)
+	}
+}
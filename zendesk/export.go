@@ -0,0 +1,47 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// DefaultExportTimeout is how long export methods wait for a page by default.
+// Exports are slow to respond, so they get more time than other calls.
+const DefaultExportTimeout = 5 * time.Minute
+
+type exportKey struct{}
+
+// SetExportTimeout changes how long export methods wait for a page.
+// It's applied unless the context passed to the method has a deadline already.
+// The Timeout of the client's *http.Client is not applied to exports, so
+// a short timeout set for other calls doesn't cancel exports midway.
+// A value less than or equal to 0 restores DefaultExportTimeout.
+func (z *Client) SetExportTimeout(timeout time.Duration) {
+	z.exportTimeout = timeout
+}
+
+// exportContext returns the context export methods send their requests with
+func (z *Client) exportContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, exportKey{}, true)
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+
+	timeout := z.exportTimeout
+	if timeout <= 0 {
+		timeout = DefaultExportTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// httpClientFor returns the HTTP client to send req with
+func (z *Client) httpClientFor(req *http.Request) *http.Client {
+	if export, _ := req.Context().Value(exportKey{}).(bool); !export || z.httpClient.Timeout == 0 {
+		return z.httpClient
+	}
+
+	httpClient := *z.httpClient
+	httpClient.Timeout = 0
+	return &httpClient
+}
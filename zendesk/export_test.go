@@ -0,0 +1,67 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestExportContext(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	exportCtx, cancel := client.exportContext(ctx)
+	defer cancel()
+
+	deadline, ok := exportCtx.Deadline()
+	if !ok || time.Until(deadline) < DefaultExportTimeout-time.Minute {
+		t.Fatalf("Export context should have the default timeout. deadline: %v", deadline)
+	}
+
+	client.SetExportTimeout(time.Second)
+	exportCtx, cancel = client.exportContext(ctx)
+	defer cancel()
+
+	deadline, _ = exportCtx.Deadline()
+	if time.Until(deadline) > time.Second {
+		t.Fatalf("Export context should have the configured timeout. deadline: %v", deadline)
+	}
+}
+
+func TestExportContextKeepsCallerDeadline(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	expected := time.Now().Add(time.Hour)
+	callerCtx, cancel := context.WithDeadline(ctx, expected)
+	defer cancel()
+
+	exportCtx, cancel := client.exportContext(callerCtx)
+	defer cancel()
+
+	if deadline, _ := exportCtx.Deadline(); !deadline.Equal(expected) {
+		t.Fatalf("Export context should keep the caller's deadline %v. deadline: %v", expected, deadline)
+	}
+}
+
+func TestExportIgnoresHTTPClientTimeout(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(&http.Client{Timeout: 10 * time.Millisecond})
+	client.SetEndpointURL(mockAPI.URL)
+
+	if _, err := client.get(ctx, "/slow.json"); err == nil {
+		t.Fatal("Expected the HTTP client timeout for a normal request")
+	}
+
+	exportCtx, cancel := client.exportContext(ctx)
+	defer cancel()
+
+	if _, err := client.get(exportCtx, "/slow.json"); err != nil {
+		t.Fatalf("Export request should not use the HTTP client timeout: %s", err)
+	}
+}
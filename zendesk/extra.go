@@ -0,0 +1,65 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// unknownFields returns the top level keys of data which are not mapped to
+// any field of v. v must be a struct or a pointer to struct.
+// It returns nil when every key is known.
+func unknownFields(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	// encoding/json matches keys case-insensitively, so do the same here
+	known := make(map[string]bool)
+	for _, key := range jsonKeys(reflect.TypeOf(v)) {
+		known[strings.ToLower(key)] = true
+	}
+
+	for key := range all {
+		if known[strings.ToLower(key)] {
+			delete(all, key)
+		}
+	}
+
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return all, nil
+}
+
+// jsonKeys collects JSON keys of struct type t including embedded structs
+func jsonKeys(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			keys = append(keys, jsonKeys(f.Type)...)
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		keys = append(keys, name)
+	}
+	return keys
+}
@@ -34,6 +34,8 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	GetAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error)
+	GetGroupAgents(ctx context.Context, groupID int64) ([]User, error)
 }
 
 // GetGroups fetches group list
@@ -51,21 +53,93 @@ func (z *Client) GetGroups(ctx context.Context, opts *GroupListOptions) ([]Group
 
 	u, err := addOptions("/groups.json", tmp)
 	if err != nil {
-		return []Group{}, Page{}, err
+		return []Group{}, Page{}, fmt.Errorf("get groups: %w", err)
 	}
 
-	body, err := z.get(ctx, u)
+	body, err := z.getCached(ctx, u)
 	if err != nil {
-		return []Group{}, Page{}, err
+		return []Group{}, Page{}, fmt.Errorf("get groups: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []Group{}, Page{}, err
+		return []Group{}, Page{}, fmt.Errorf("get groups: %w", err)
 	}
 	return data.Groups, data.Page, nil
 }
 
+// GetAssignableGroups fetches the groups which tickets can be assigned to
+// https://developer.zendesk.com/api-reference/ticketing/groups/groups/#list-assignable-groups
+func (z *Client) GetAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error) {
+	var data struct {
+		Groups []Group `json:"groups"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &GroupListOptions{}
+	}
+
+	u, err := addOptions("/groups/assignable.json", tmp)
+	if err != nil {
+		return []Group{}, Page{}, fmt.Errorf("get assignable groups: %w", err)
+	}
+
+	body, err := z.getCached(ctx, u)
+	if err != nil {
+		return []Group{}, Page{}, fmt.Errorf("get assignable groups: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Group{}, Page{}, fmt.Errorf("get assignable groups: %w", err)
+	}
+	return data.Groups, data.Page, nil
+}
+
+// GetGroupAgents fetches the active agents and admins of the group from all pages,
+// which are the users tickets of the group can be assigned to.
+// Suspended and deleted users are left out.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+func (z *Client) GetGroupAgents(ctx context.Context, groupID int64) ([]User, error) {
+	opts := PageOptions{PerPage: maxPerPage, Page: 1}
+
+	var agents []User
+	for {
+		var data struct {
+			Users []User `json:"users"`
+			Page
+		}
+
+		u, err := addOptions(fmt.Sprintf("/groups/%d/users.json", groupID), opts)
+		if err != nil {
+			return nil, fmt.Errorf("get group agents %d: %w", groupID, err)
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return nil, fmt.Errorf("get group agents %d: %w", groupID, err)
+		}
+
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return nil, fmt.Errorf("get group agents %d: %w", groupID, err)
+		}
+
+		for _, user := range data.Users {
+			if user.Active && !user.Suspended && user.Role != userRoleText[UserRoleEndUser] {
+				agents = append(agents, user)
+			}
+		}
+
+		if !data.Page.HasNext() {
+			return agents, nil
+		}
+		opts.Page++
+	}
+}
+
 // CreateGroup creates new group
 // https://developer.zendesk.com/rest_api/docs/support/groups#create-group
 func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
@@ -76,12 +150,12 @@ func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
 
 	body, err := z.post(ctx, "/groups.json", data)
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("create group: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("create group: %w", err)
 	}
 	return result.Group, nil
 }
@@ -93,15 +167,15 @@ func (z *Client) GetGroup(ctx context.Context, groupID int64) (Group, error) {
 		Group Group `json:"group"`
 	}
 
-	body, err := z.get(ctx, fmt.Sprintf("/groups/%d.json", groupID))
+	body, err := z.getCached(ctx, fmt.Sprintf("/groups/%d.json", groupID))
 
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("get group %d: %w", groupID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("get group %d: %w", groupID, err)
 	}
 
 	return result.Group, err
@@ -118,12 +192,12 @@ func (z *Client) UpdateGroup(ctx context.Context, groupID int64, group Group) (G
 	body, err := z.put(ctx, fmt.Sprintf("/groups/%d.json", groupID), data)
 
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("update group %d: %w", groupID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Group{}, err
+		return Group{}, fmt.Errorf("update group %d: %w", groupID, err)
 	}
 
 	return result.Group, err
@@ -135,7 +209,7 @@ func (z *Client) DeleteGroup(ctx context.Context, groupID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/groups/%d.json", groupID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete group %d: %w", groupID, err)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -49,16 +50,16 @@ func (z *Client) GetGroupMemberships(ctx context.Context, opts *GroupMembershipL
 
 	u, err := addOptions("/group_memberships.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get group memberships: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get group memberships: %w", err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get group memberships: %w", err)
 	}
 
 	return result.GroupMemberships, result.Page, nil
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +77,54 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestGetAssignableGroups(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/assignable.json" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.GetAssignableGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable groups: %s", err)
+	}
+
+	if len(groups) != 1 {
+		t.Fatalf("expected length of groups is 1, but got %d", len(groups))
+	}
+}
+
+func TestGetGroupAgents(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"users": [
+			{"id": 1, "role": "agent", "active": true},
+			{"id": 2, "role": "end-user", "active": true},
+			{"id": 3, "role": "agent", "active": true, "suspended": true}
+		], "next_page": "https://example.zendesk.com/api/v2/groups/360/users.json?page=2"}`,
+		"2": `{"users": [
+			{"id": 4, "role": "admin", "active": true},
+			{"id": 5, "role": "agent", "active": false}
+		], "next_page": null}`,
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/360/users.json" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(pages[r.URL.Query().Get("page")]))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agents, err := client.GetGroupAgents(ctx, 360)
+	if err != nil {
+		t.Fatalf("Failed to get group agents: %s", err)
+	}
+
+	if len(agents) != 2 || agents[0].ID != 1 || agents[1].ID != 4 {
+		t.Fatalf("unexpected agents %v", agents)
+	}
+}
@@ -0,0 +1,132 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetIncrementalTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets/cursor.json" || r.URL.Query().Get("start_time") != "1332034771" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{
+			"tickets": [{"id": 1}, {"id": 2}],
+			"after_url": "https://example.zendesk.com/api/v2/incremental/tickets/cursor.json?cursor=MTU3",
+			"after_cursor": "MTU3",
+			"end_of_stream": false
+		}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, cursor, err := client.GetIncrementalTickets(ctx, CursorOption{StartTime: 1332034771})
+	if err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	if len(tickets) != 2 || cursor.AfterCursor != "MTU3" || cursor.EndOfStream {
+		t.Fatalf("unexpected page %v %+v", tickets, cursor)
+	}
+}
+
+// newIncrementalTicketsAPI serves pages of one ticket each, chained by cursors "c1", "c2", ...
+// Page n is the end of the stream.
+func newIncrementalTicketsAPI(t *testing.T, n int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			fmt.Sscanf(cursor, "c%d", &page)
+		} else if r.URL.Query().Get("start_time") == "" {
+			t.Errorf("expected start_time or cursor: %s", r.URL)
+		}
+
+		if page >= n {
+			fmt.Fprintf(w, `{"tickets": [], "after_cursor": "c%d", "end_of_stream": true}`, page)
+			return
+		}
+		fmt.Fprintf(w, `{"tickets": [{"id": %d}], "after_cursor": "c%d", "end_of_stream": %t}`, page+1, page+1, page+1 == n)
+	}))
+}
+
+func TestIncrementalExporter(t *testing.T) {
+	mockAPI := newIncrementalTicketsAPI(t, 3)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	var checkpoints []string
+	exporter := client.IncrementalTicketExporter(CursorOption{StartTime: 1}).
+		OnCheckpoint(func(ctx context.Context, cursor string) error {
+			checkpoints = append(checkpoints, cursor)
+			return nil
+		})
+
+	err := exporter.Export(ctx, func(ctx context.Context, tickets []Ticket) error {
+		for _, ticket := range tickets {
+			ids = append(ids, ticket.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to export: %s", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int64{1, 2, 3}) {
+		t.Fatalf("unexpected tickets %v", ids)
+	}
+	if !reflect.DeepEqual(checkpoints, []string{"c1", "c2", "c3"}) {
+		t.Fatalf("unexpected checkpoints %v", checkpoints)
+	}
+	if !exporter.CaughtUp() || exporter.Cursor() != "c3" {
+		t.Fatalf("expected to be caught up at c3, but got %t %s", exporter.CaughtUp(), exporter.Cursor())
+	}
+
+	// polling again after catching up reads nothing new
+	err = exporter.Export(ctx, func(ctx context.Context, tickets []Ticket) error {
+		t.Fatalf("unexpected tickets %v", tickets)
+		return nil
+	})
+	if err != nil || !exporter.CaughtUp() || len(checkpoints) != 3 {
+		t.Fatalf("unexpected poll result %v %t %v", err, exporter.CaughtUp(), checkpoints)
+	}
+}
+
+func TestIncrementalExporterResume(t *testing.T) {
+	mockAPI := newIncrementalTicketsAPI(t, 3)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	errFailed := errors.New("warehouse down")
+	exporter := client.IncrementalTicketExporter(CursorOption{StartTime: 1})
+	err := exporter.Export(ctx, func(ctx context.Context, tickets []Ticket) error {
+		if tickets[0].ID == 2 {
+			return errFailed
+		}
+		return nil
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of handle, but got %v", err)
+	}
+	if exporter.CaughtUp() || exporter.Cursor() != "c1" {
+		t.Fatalf("expected the cursor to stay before the failed batch, but got %s", exporter.Cursor())
+	}
+
+	var ids []int64
+	resumed := client.IncrementalTicketExporter(CursorOption{Cursor: exporter.Cursor()})
+	err = resumed.Export(ctx, func(ctx context.Context, tickets []Ticket) error {
+		ids = append(ids, tickets[0].ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to resume export: %s", err)
+	}
+	if !reflect.DeepEqual(ids, []int64{2, 3}) {
+		t.Fatalf("expected to resume from ticket 2, but got %v", ids)
+	}
+}
@@ -0,0 +1,169 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Statuses of a background job
+const (
+	JobStatusQueued    = "queued"
+	JobStatusWorking   = "working"
+	JobStatusFailed    = "failed"
+	JobStatusCompleted = "completed"
+	JobStatusKilled    = "killed"
+)
+
+// JobStatus is the status of a background job which Zendesk runs for bulk operations.
+// Results are not paginated: a bulk endpoint accepts at most 100 items per job,
+// so Results holds every item of the job once it is done.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/
+type JobStatus struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url,omitempty"`
+	Total    int               `json:"total,omitempty"`
+	Progress int               `json:"progress,omitempty"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message,omitempty"`
+	Results  []JobStatusResult `json:"results,omitempty"`
+}
+
+// JobStatusResult is the result for one of the items processed by a job
+type JobStatusResult struct {
+	ID      int64  `json:"id"`
+	Action  string `json:"action,omitempty"`
+	Success bool   `json:"success,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Details string `json:"details,omitempty"`
+}
+
+// Failed reports whether the item was not processed. Zendesk omits success
+// and sets error and details for items which failed.
+func (r JobStatusResult) Failed() bool {
+	return !r.Success && (r.Error != "" || r.Status == "Failed")
+}
+
+// FailedJobItems returns the results of the items which the job failed to process,
+// so that only those items can be retried
+func FailedJobItems(js JobStatus) []JobStatusResult {
+	var failed []JobStatusResult
+	for _, r := range js.Results {
+		if r.Failed() {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// Done reports whether the job has finished, successfully or not
+func (j JobStatus) Done() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed || j.Status == JobStatusKilled
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, jobID string) (JobStatus, error)
+	GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error)
+}
+
+// GetJobStatus gets the status of the specified job
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, jobID string) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", jobID))
+	if err != nil {
+		return JobStatus{}, fmt.Errorf("get job status %s: %w", jobID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, fmt.Errorf("get job status %s: %w", jobID, err)
+	}
+
+	return result.JobStatus, nil
+}
+
+// showManyJobStatusesLimit is the maximum number of job IDs which
+// /job_statuses/show_many.json accepts at once
+const showManyJobStatusesLimit = 100
+
+// GetManyJobStatuses gets the statuses of the specified jobs, requesting them
+// in batches of 100. Combined with JobResults, it gives every per-item outcome
+// of an operation which was split into several jobs.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-many-job-statuses
+func (z *Client) GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error) {
+	var jobs []JobStatus
+	for start := 0; start < len(jobIDs); start += showManyJobStatusesLimit {
+		end := start + showManyJobStatusesLimit
+		if end > len(jobIDs) {
+			end = len(jobIDs)
+		}
+
+		var req struct {
+			IDs string `url:"ids,omitempty"`
+		}
+		req.IDs = strings.Join(jobIDs[start:end], ",")
+
+		u, err := addOptions("/job_statuses/show_many.json", req)
+		if err != nil {
+			return jobs, fmt.Errorf("get many job statuses: %w", err)
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return jobs, fmt.Errorf("get many job statuses: %w", err)
+		}
+
+		var result struct {
+			JobStatuses []JobStatus `json:"job_statuses"`
+		}
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return jobs, fmt.Errorf("get many job statuses: %w", err)
+		}
+		jobs = append(jobs, result.JobStatuses...)
+	}
+
+	return jobs, nil
+}
+
+// JobResults returns the results of all of the jobs in order
+func JobResults(jobs []JobStatus) []JobStatusResult {
+	var results []JobStatusResult
+	for _, j := range jobs {
+		results = append(results, j.Results...)
+	}
+	return results
+}
+
+// jobPollInterval is how long waitJobStatus sleeps between polls
+var jobPollInterval = time.Second
+
+// waitJobStatus polls the job until it is done or ctx is cancelled
+func (z *Client) waitJobStatus(ctx context.Context, job JobStatus) (JobStatus, error) {
+	for !job.Done() {
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-time.After(jobPollInterval):
+		}
+
+		var err error
+		job, err = z.GetJobStatus(ctx, job.ID)
+		if err != nil {
+			return job, err
+		}
+	}
+
+	return job, nil
+}
@@ -0,0 +1,117 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.GetJobStatus(ctx, "8b726e606741012ffc2d782bcb7848fe")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+
+	if job.Status != JobStatusCompleted || !job.Done() {
+		t.Fatalf("Job should be completed. status: %s", job.Status)
+	}
+
+	if len(job.Results) != 2 || job.Results[0].ID != 4 {
+		t.Fatalf("Unexpected job results: %v", job.Results)
+	}
+}
+
+func TestWaitJobStatusCancel(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "update_many.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	interval := jobPollInterval
+	jobPollInterval = time.Hour
+	defer func() { jobPollInterval = interval }()
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.waitJobStatus(cancelCtx, JobStatus{ID: "1", Status: JobStatusQueued})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, but got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Polling should stop as soon as the context is cancelled. elapsed: %s", elapsed)
+	}
+}
+
+func TestFailedJobItems(t *testing.T) {
+	var js JobStatus
+	err := json.Unmarshal([]byte(`{
+		"id": "8b726e606741012ffc2d782bcb7848fe",
+		"status": "completed",
+		"results": [
+			{"id": 4, "action": "update", "success": true, "status": "Updated"},
+			{"id": 5, "action": "update", "status": "Failed", "error": "TicketUpdateFailed", "details": "Status: closed prevents ticket update"}
+		]
+	}`), &js)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal job status: %s", err)
+	}
+
+	failed := FailedJobItems(js)
+	if len(failed) != 1 {
+		t.Fatalf("expected length of failed items is 1, but got %d", len(failed))
+	}
+
+	if failed[0].ID != 5 || failed[0].Error != "TicketUpdateFailed" {
+		t.Fatalf("Unexpected failed item %v", failed[0])
+	}
+}
+
+func TestGetManyJobStatuses(t *testing.T) {
+	var requests []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		requests = append(requests, r.URL.Path)
+
+		var statuses []string
+		for _, id := range ids {
+			statuses = append(statuses, fmt.Sprintf(`{"id": %q, "status": "completed", "results": [{"id": 1, "success": true}]}`, id))
+		}
+		fmt.Fprintf(w, `{"job_statuses": [%s]}`, strings.Join(statuses, ","))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("job%d", i)
+	}
+
+	jobs, err := client.GetManyJobStatuses(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to get many job statuses: %s", err)
+	}
+
+	if len(requests) != 2 || requests[0] != "/job_statuses/show_many.json" {
+		t.Fatalf("Expected 2 requests to show_many, but got %v", requests)
+	}
+
+	if len(jobs) != 150 || jobs[149].ID != "job149" {
+		t.Fatalf("Unexpected jobs: %d", len(jobs))
+	}
+
+	if results := JobResults(jobs); len(results) != 150 {
+		t.Fatalf("Expected 150 results, but got %d", len(results))
+	}
+}
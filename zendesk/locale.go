@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -31,12 +32,12 @@ func (z *Client) GetLocales(ctx context.Context) ([]Locale, error) {
 
 	body, err := z.get(ctx, "/locales.json")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get locales: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get locales: %w", err)
 	}
 	return data.Locales, nil
 }
@@ -0,0 +1,79 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Messages of the events which the client logs
+const (
+	LogRateLimitWait = "rate limit wait"
+	LogRetryWait     = "retry wait"
+	LogDryRun        = "dry run"
+)
+
+// Logger receives structured events of the client. Fields are key-value pairs,
+// e.g. "method", "path" and "wait" for LogRateLimitWait.
+type Logger interface {
+	Log(ctx context.Context, msg string, fields map[string]interface{})
+}
+
+// LoggerFunc is a function which can be used as a Logger
+type LoggerFunc func(ctx context.Context, msg string, fields map[string]interface{})
+
+// Log calls f
+func (f LoggerFunc) Log(ctx context.Context, msg string, fields map[string]interface{}) {
+	f(ctx, msg, fields)
+}
+
+// SetLogger saves a logger which receives events of the client:
+//
+//   - LogRateLimitWait when a request waited for the limiter of SetRateLimit
+//   - LogRetryWait when a request waits to be retried as configured by SetRetry,
+//     with "attempt", and "status" or "error" of the failed attempt
+//   - LogDryRun when a request is skipped as configured by SetDryRun,
+//     with "method", "path", and the JSON "body" or the "size" of an upload
+//
+// The wait events have "method", "path" and "wait", the time.Duration of the wait.
+// Request headers are never logged, so the fields contain no credentials.
+// A panic in the logger is recovered and does not affect the request.
+func (z *Client) SetLogger(logger Logger) {
+	z.logger = logger
+}
+
+// log sends an event to the logger and recovers from its panic
+func (z *Client) log(ctx context.Context, msg string, fields map[string]interface{}) {
+	if z.logger == nil {
+		return
+	}
+
+	defer func() { recover() }()
+	z.logger.Log(ctx, msg, fields)
+}
+
+// logRateLimitWait logs that req waited for the rate limiter
+func (z *Client) logRateLimitWait(req *http.Request, wait time.Duration) {
+	z.log(req.Context(), LogRateLimitWait, map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"wait":   wait,
+	})
+}
+
+// logRetryWait logs that req waits to be retried after the failed attempt
+func (z *Client) logRetryWait(req *http.Request, resp *http.Response, err error, attempt int, wait time.Duration) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"wait":    wait,
+		"attempt": attempt + 1,
+	}
+	if resp != nil {
+		fields["status"] = resp.StatusCode
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	z.log(req.Context(), LogRetryWait, fields)
+}
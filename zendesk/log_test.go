@@ -0,0 +1,118 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type logEvent struct {
+	msg    string
+	fields map[string]interface{}
+}
+
+func newRecordingLogger() (Logger, func() []logEvent) {
+	var (
+		mu     sync.Mutex
+		events []logEvent
+	)
+	logger := LoggerFunc(func(ctx context.Context, msg string, fields map[string]interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, logEvent{msg, fields})
+	})
+	return logger, func() []logEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		return events
+	}
+}
+
+func TestLogRateLimitWait(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	logger, events := newRecordingLogger()
+	client.SetLogger(logger)
+	client.SetRateLimit(1200) // one request per 50ms
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.get(ctx, "/groups.json"); err != nil {
+			t.Fatalf("Failed to send request: %s", err)
+		}
+	}
+
+	logged := events()
+	if len(logged) != 1 || logged[0].msg != LogRateLimitWait {
+		t.Fatalf("expected one rate limit wait, but got %v", logged)
+	}
+
+	fields := logged[0].fields
+	if fields["method"] != http.MethodGet || fields["path"] != "/groups.json" {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+	if wait, ok := fields["wait"].(time.Duration); !ok || wait <= 0 {
+		t.Fatalf("expected a positive wait, but got %v", fields["wait"])
+	}
+}
+
+func TestLogRetryWait(t *testing.T) {
+	defer func(d time.Duration) { retryBaseDelay = d }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var calls int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	logger, events := newRecordingLogger()
+	client.SetLogger(logger)
+	client.SetRetry(2, DefaultShouldRetry)
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+
+	logged := events()
+	if len(logged) != 1 || logged[0].msg != LogRetryWait {
+		t.Fatalf("expected one retry wait, but got %v", logged)
+	}
+
+	fields := logged[0].fields
+	if fields["status"] != http.StatusTooManyRequests || fields["attempt"] != 1 || fields["path"] != "/groups.json" {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+	if _, ok := fields["wait"].(time.Duration); !ok {
+		t.Fatalf("expected wait to be a time.Duration, but got %v", fields["wait"])
+	}
+}
+
+func TestLoggerPanic(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetLogger(LoggerFunc(func(ctx context.Context, msg string, fields map[string]interface{}) {
+		panic("logger failed")
+	}))
+	client.SetRateLimit(1200)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.get(ctx, "/groups.json"); err != nil {
+			t.Fatalf("Failed to send request: %s", err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,34 +13,181 @@ import (
 type Macro struct {
 	Actions     []MacroAction `json:"actions"`
 	Active      bool          `json:"active"`
-	CreatedAt   time.Time     `json:"created_at,omitempty"`
+	CreatedAt   ZendeskTime   `json:"created_at,omitempty"`
 	Description interface{}   `json:"description"`
 	ID          int64         `json:"id,omitempty"`
 	Position    int           `json:"position,omitempty"`
 	Restriction interface{}   `json:"restriction"`
 	Title       string        `json:"title"`
-	UpdatedAt   time.Time     `json:"updated_at,omitempty"`
+	UpdatedAt   ZendeskTime   `json:"updated_at,omitempty"`
 	URL         string        `json:"url,omitempty"`
+
+	// Usage counts are only returned when requested with MacroListOptions.Include,
+	// e.g. by GetMacrosByUsage
+	Usage1h  int `json:"usage_1h,omitempty"`
+	Usage24h int `json:"usage_24h,omitempty"`
+	Usage7d  int `json:"usage_7d,omitempty"`
+	Usage30d int `json:"usage_30d,omitempty"`
+
+	// Permissions is only returned when requested with MacroListOptions.Include "permissions"
+	Permissions *MacroPermissions `json:"permissions,omitempty"`
+
+	// Category is the category agents see the macro under, as GetMacroCategory
+	// derives it from Title. It is populated on decode and never sent to the API.
+	Category string `json:"-"`
+
+	// Extra holds fields returned by Zendesk which are not mapped to Macro yet.
+	// It is populated on decode and never sent back to the API.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes a macro and keeps unknown fields in Extra
+func (m *Macro) UnmarshalJSON(data []byte) error {
+	type macro Macro
+	var tmp macro
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, tmp)
+	if err != nil {
+		return err
+	}
+
+	*m = Macro(tmp)
+	m.Category = macroCategory(m.Title)
+	m.Extra = extra
+	return nil
+}
+
+// MacroPermissions is what the authenticated user can do with a macro
+type MacroPermissions struct {
+	CanEdit bool `json:"can_edit"`
+}
+
+// Scopes of MacroAccess
+const (
+	MacroScopeAccount  = "account"
+	MacroScopeGroup    = "group"
+	MacroScopePersonal = "personal"
+)
+
+// MacroAccess is who can use a macro, read from Macro.Restriction
+type MacroAccess struct {
+	// Scope is MacroScopeAccount for macros all agents can use,
+	// MacroScopeGroup for macros shared with groups, or
+	// MacroScopePersonal for macros of a single agent
+	Scope string
+
+	GroupIDs []int64
+	UserID   int64
+}
+
+// Access returns who can use the macro
+func (m Macro) Access() MacroAccess {
+	restriction, ok := m.Restriction.(map[string]interface{})
+	if !ok || len(restriction) == 0 {
+		return MacroAccess{Scope: MacroScopeAccount}
+	}
+
+	id, _ := restriction["id"].(float64)
+	switch restriction["type"] {
+	case "User":
+		return MacroAccess{Scope: MacroScopePersonal, UserID: int64(id)}
+	case "Group":
+		access := MacroAccess{Scope: MacroScopeGroup}
+		ids, _ := restriction["ids"].([]interface{})
+		for _, v := range ids {
+			if groupID, ok := v.(float64); ok {
+				access.GroupIDs = append(access.GroupIDs, int64(groupID))
+			}
+		}
+		if len(access.GroupIDs) == 0 && id != 0 {
+			access.GroupIDs = []int64{int64(id)}
+		}
+		return access
+	default:
+		return MacroAccess{Scope: MacroScopeAccount}
+	}
+}
+
+// macroCategorySeparator separates the category from the rest of a macro title
+const macroCategorySeparator = "::"
+
+// GetMacroCategory returns the category of the macro. Zendesk has no category
+// field: agents see a macro titled "Billing::Refunds::Approve" under the
+// "Billing" category, which is what is returned. It is "" for macros whose
+// title has no category. Unlike Macro.Category, it is also derived for macros
+// which were not decoded from a response.
+func GetMacroCategory(macro Macro) string {
+	return macroCategory(macro.Title)
+}
+
+func macroCategory(title string) string {
+	i := strings.Index(title, macroCategorySeparator)
+	if i < 0 {
+		return ""
+	}
+	return strings.TrimSpace(title[:i])
+}
+
+// MarshalJSON encodes a macro. CreatedAt and UpdatedAt are left out when they are zero,
+// which omitempty can't do for structs, so that a macro decoded from a response
+// without them is encoded to the same JSON.
+func (m Macro) MarshalJSON() ([]byte, error) {
+	type macro Macro
+	tmp := struct {
+		macro
+		CreatedAt *ZendeskTime `json:"created_at,omitempty"`
+		UpdatedAt *ZendeskTime `json:"updated_at,omitempty"`
+	}{macro: macro(m)}
+
+	if !m.CreatedAt.IsZero() {
+		tmp.CreatedAt = &m.CreatedAt
+	}
+	if !m.UpdatedAt.IsZero() {
+		tmp.UpdatedAt = &m.UpdatedAt
+	}
+	return json.Marshal(tmp)
+}
+
+// MacroSummary is a lighter version of Macro for listing many macros.
+// Actions and other large fields are not decoded at all.
+type MacroSummary struct {
+	ID     int64  `json:"id"`
+	Title  string `json:"title"`
+	Active bool   `json:"active"`
 }
 
 // MacroAction is definition of what the macro does to the ticket
 //
 // ref: https://develop.zendesk.com/hc/en-us/articles/360056760874-Support-API-Actions-reference
 type MacroAction struct {
-	Field string   `json:"field"`
-	Value []string `json:"value"`
+	Field string `json:"field"`
+	// Value is a string for most fields and an array of strings for
+	// fields such as notification_user, the same as TriggerAction.Value
+	Value interface{} `json:"value"`
 }
 
+// Periods of macro usage which can be passed to GetMacrosByUsage
+const (
+	MacroUsage1h  = "usage_1h"
+	MacroUsage24h = "usage_24h"
+	MacroUsage7d  = "usage_7d"
+	MacroUsage30d = "usage_30d"
+)
+
 // MacroListOptions is parameters used of GetMacros
 type MacroListOptions struct {
-	Access       string `json:"access"`
-	Active       string `json:"active"`
-	Category     int    `json:"category"`
-	GroupID      int    `json:"group_id"`
-	Include      string `json:"include"`
-	OnlyViewable bool   `json:"only_viewable"`
+	Access       string `url:"access,omitempty"`
+	Active       string `url:"active,omitempty"`
+	Category     int    `url:"category,omitempty"`
+	GroupID      int    `url:"group_id,omitempty"`
+	Include      string `url:"include,omitempty"`
+	OnlyViewable bool   `url:"only_viewable,omitempty"`
 
 	PageOptions
+	TimeFilter
 
 	// SortBy can take "created_at", "updated_at", "usage_1h", "usage_24h",
 	// "usage_7d", "usage_30d", "alphabetical"
@@ -52,10 +200,24 @@ type MacroListOptions struct {
 // MacroAPI an interface containing all macro related methods
 type MacroAPI interface {
 	GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro, Page, error)
+	GetMacroSummaries(ctx context.Context, opts *MacroListOptions) ([]MacroSummary, Page, error)
+	IterateMacros(opts *MacroListOptions) *MacroIterator
+	GetAllActiveMacros(ctx context.Context) ([]Macro, error)
+	GetMacrosByUsage(ctx context.Context, period string, opts *MacroListOptions) ([]Macro, Page, error)
 	GetMacro(ctx context.Context, macroID int64) (Macro, error)
+	MacrosChangedSince(ctx context.Context, since time.Time) ([]Macro, error)
 	CreateMacro(ctx context.Context, macro Macro) (Macro, error)
+	CloneMacro(ctx context.Context, macroID int64, newTitle string) (Macro, error)
 	UpdateMacro(ctx context.Context, macroID int64, macro Macro) (Macro, error)
 	DeleteMacro(ctx context.Context, macroID int64) error
+	SyncMacroPositions(ctx context.Context, orderedIDs []int64) error
+	GetMacroDefinitions(ctx context.Context) (MacroDefinitions, error)
+	GetMacroCategories(ctx context.Context) ([]string, error)
+	ActivateMacro(ctx context.Context, macroID int64) (Macro, error)
+	DeactivateMacro(ctx context.Context, macroID int64) (Macro, error)
+	ApplyMacroToSearchResults(ctx context.Context, query string, macroID int64) (ApplyMacroSummary, error)
+	EnsureMacro(ctx context.Context, macro Macro) (Macro, bool, error)
+	MacroChangeSummary(ctx context.Context, ticketID, macroID int64) ([]FieldChange, error)
 	ShowChangesToTicket(ctx context.Context, macroID int64) (Ticket, error)
 	ShowTicketAfterChanges(ctx context.Context, ticketID, macroID int64) (Ticket, error)
 }
@@ -76,17 +238,49 @@ func (z *Client) GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro
 
 	u, err := addOptions("/macros.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get macros: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get macros: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get macros: %w", err)
+	}
+	return data.Macros, data.Page, nil
+}
+
+// GetMacroSummaries gets macro list like GetMacros, but only decodes
+// the ID, title and active flag of each macro to save allocations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-macros
+func (z *Client) GetMacroSummaries(ctx context.Context, opts *MacroListOptions) ([]MacroSummary, Page, error) {
+	var data struct {
+		Macros []MacroSummary `json:"macros"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &MacroListOptions{}
+	}
+
+	u, err := addOptions("/macros.json", tmp)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get macro summaries: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get macro summaries: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get macro summaries: %w", err)
 	}
 	return data.Macros, data.Page, nil
 }
@@ -101,17 +295,80 @@ func (z *Client) GetMacro(ctx context.Context, macroID int64) (Macro, error) {
 
 	body, err := z.get(ctx, fmt.Sprintf("/macros/%d.json", macroID))
 	if err != nil {
-		return Macro{}, err
+		return Macro{}, fmt.Errorf("get macro %d: %w", macroID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Macro{}, err
+		return Macro{}, fmt.Errorf("get macro %d: %w", macroID, err)
 	}
 
 	return result.Macro, err
 }
 
+// GetAllActiveMacros gets all active macros the user can use, going through every page
+func (z *Client) GetAllActiveMacros(ctx context.Context) ([]Macro, error) {
+	it := z.IterateMacros(&MacroListOptions{
+		Active:       "true",
+		OnlyViewable: true,
+	})
+
+	var macros []Macro
+	for it.Next(ctx) {
+		macros = append(macros, it.Macro())
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("get all active macros: %w", err)
+	}
+
+	return macros, nil
+}
+
+// GetMacrosByUsage gets macros sorted by how many times they were used in period,
+// which is one of MacroUsage1h, MacroUsage24h, MacroUsage7d or MacroUsage30d.
+// The usage count for period is set to the macros. Zendesk doesn't report usage
+// per agent. Set opts.SortOrder to "asc" to get the least used macros first.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macros
+func (z *Client) GetMacrosByUsage(ctx context.Context, period string, opts *MacroListOptions) ([]Macro, Page, error) {
+	tmp := MacroListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	tmp.SortBy = period
+	tmp.Include = period
+
+	macros, page, err := z.GetMacros(ctx, &tmp)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get macros by %s: %w", period, err)
+	}
+	return macros, page, nil
+}
+
+// MacrosChangedSince gets macros updated at or after since, newest first.
+// Macros are listed in updated_at descending order and paging stops at the
+// first macro older than since, so only the changed macros are fetched.
+func (z *Client) MacrosChangedSince(ctx context.Context, since time.Time) ([]Macro, error) {
+	it := z.IterateMacros(&MacroListOptions{
+		SortBy:    "updated_at",
+		SortOrder: "desc",
+	})
+
+	var changed []Macro
+	for it.Next(ctx) {
+		macro := it.Macro()
+		if macro.UpdatedAt.Before(since) {
+			break
+		}
+		changed = append(changed, macro)
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("macros changed since %s: %w", since.Format(time.RFC3339), err)
+	}
+
+	return changed, nil
+}
+
 // CreateMacro create a new macro
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/macros#create-macro
@@ -123,16 +380,41 @@ func (z *Client) CreateMacro(ctx context.Context, macro Macro) (Macro, error) {
 
 	body, err := z.post(ctx, "/macros.json", data)
 	if err != nil {
-		return Macro{}, err
+		return Macro{}, fmt.Errorf("create macro: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Macro{}, err
+		return Macro{}, fmt.Errorf("create macro: %w", err)
 	}
 	return result.Macro, nil
 }
 
+// CloneMacro creates a copy of the specified macro with newTitle.
+// Fields managed by Zendesk (ID, URL, CreatedAt, UpdatedAt and Position)
+// are not copied.
+func (z *Client) CloneMacro(ctx context.Context, macroID int64, newTitle string) (Macro, error) {
+	source, err := z.GetMacro(ctx, macroID)
+	if err != nil {
+		return Macro{}, fmt.Errorf("clone macro %d: %w", macroID, err)
+	}
+
+	clone := Macro{
+		Actions:     source.Actions,
+		Active:      source.Active,
+		Description: source.Description,
+		Restriction: source.Restriction,
+		Title:       newTitle,
+	}
+
+	created, err := z.CreateMacro(ctx, clone)
+	if err != nil {
+		return Macro{}, fmt.Errorf("clone macro %d: %w", macroID, err)
+	}
+
+	return created, nil
+}
+
 // UpdateMacro update an existing macro
 // ref: https://developer.zendesk.com/rest_api/docs/support/macros#update-macro
 func (z *Client) UpdateMacro(ctx context.Context, macroID int64, macro Macro) (Macro, error) {
@@ -144,14 +426,59 @@ func (z *Client) UpdateMacro(ctx context.Context, macroID int64, macro Macro) (M
 	path := fmt.Sprintf("/macros/%d.json", macroID)
 	body, err := z.put(ctx, path, data)
 	if err != nil {
-		return Macro{}, err
+		return Macro{}, fmt.Errorf("update macro %d: %w", macroID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Macro{}, fmt.Errorf("update macro %d: %w", macroID, err)
+	}
+
+	return result.Macro, nil
+}
+
+// ActivateMacro makes the specified macro active again
+func (z *Client) ActivateMacro(ctx context.Context, macroID int64) (Macro, error) {
+	macro, err := z.setMacroActive(ctx, macroID, true)
+	if err != nil {
+		return Macro{}, fmt.Errorf("activate macro %d: %w", macroID, err)
+	}
+	return macro, nil
+}
+
+// DeactivateMacro makes the specified macro inactive. Unlike DeleteMacro,
+// the macro is kept and can be activated again with ActivateMacro.
+func (z *Client) DeactivateMacro(ctx context.Context, macroID int64) (Macro, error) {
+	macro, err := z.setMacroActive(ctx, macroID, false)
+	if err != nil {
+		return Macro{}, fmt.Errorf("deactivate macro %d: %w", macroID, err)
+	}
+	return macro, nil
+}
+
+// setMacroActive updates only the active flag of a macro. UpdateMacro can't be
+// used for this because it sends the other fields of Macro even when they are empty.
+func (z *Client) setMacroActive(ctx context.Context, macroID int64, active bool) (Macro, error) {
+	var data struct {
+		Macro struct {
+			Active bool `json:"active"`
+		} `json:"macro"`
+	}
+	data.Macro.Active = active
+
+	var result struct {
+		Macro Macro `json:"macro"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/macros/%d.json", macroID), data)
 	if err != nil {
 		return Macro{}, err
 	}
 
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Macro{}, err
+	}
 	return result.Macro, nil
 }
 
@@ -161,7 +488,7 @@ func (z *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/macros/%d.json", macroID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete macro %d: %w", macroID, err)
 	}
 
 	return nil
@@ -173,7 +500,7 @@ func (z *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 func (z *Client) ShowChangesToTicket(ctx context.Context, macroID int64) (Ticket, error) {
 	body, err := z.get(ctx, fmt.Sprintf("/macros/%d/apply.json", macroID))
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("show changes to ticket of macro %d: %w", macroID, err)
 	}
 
 	unmarshal := func(data []byte) (Ticket, error) {
@@ -204,17 +531,17 @@ func (z *Client) ShowChangesToTicket(ctx context.Context, macroID int64) (Ticket
 		var r results
 		err := json.Unmarshal(data, &r)
 		if err != nil {
-			return Ticket{}, err
+			return Ticket{}, fmt.Errorf("show changes to ticket of macro %d: %w", macroID, err)
 		}
 
 		commentIsPublic, err := strconv.ParseBool(r.Result.Ticket.Comment.Public)
 		if err != nil {
-			return Ticket{}, err
+			return Ticket{}, fmt.Errorf("show changes to ticket of macro %d: %w", macroID, err)
 		}
 
 		ticketFormId, err := strconv.ParseInt(r.Result.Ticket.TicketFormID, 10, 64)
 		if err != nil {
-			return Ticket{}, err
+			return Ticket{}, fmt.Errorf("show changes to ticket of macro %d: %w", macroID, err)
 		}
 
 		return Ticket{
@@ -242,7 +569,7 @@ func (z *Client) ShowChangesToTicket(ctx context.Context, macroID int64) (Ticket
 func (z *Client) ShowTicketAfterChanges(ctx context.Context, ticketID, macroID int64) (Ticket, error) {
 	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/macros/%d/apply", ticketID, macroID))
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("show ticket %d after changes of macro %d: %w", ticketID, macroID, err)
 	}
 
 	unmarshal := func(data []byte) (Ticket, error) {
@@ -260,6 +587,11 @@ func (z *Client) ShowTicketAfterChanges(ctx context.Context, ticketID, macroID i
 					FollowerIDs     []int64       `json:"follower_ids"`
 					Status          string        `json:"status"`
 					CustomFields    []CustomField `json:"custom_fields,omitempty"`
+
+					Priority   string `json:"priority"`
+					Type       string `json:"type"`
+					AssigneeID int64  `json:"assignee_id"`
+					GroupID    int64  `json:"group_id"`
 				} `json:"ticket"`
 			} `json:"result"`
 		}
@@ -267,12 +599,12 @@ func (z *Client) ShowTicketAfterChanges(ctx context.Context, ticketID, macroID i
 		var r results
 		err := json.Unmarshal(data, &r)
 		if err != nil {
-			return Ticket{}, err
+			return Ticket{}, fmt.Errorf("show ticket %d after changes of macro %d: %w", ticketID, macroID, err)
 		}
 
 		commentIsPublic, err := strconv.ParseBool(r.Result.Ticket.Comment.Public)
 		if err != nil {
-			return Ticket{}, err
+			return Ticket{}, fmt.Errorf("show ticket %d after changes of macro %d: %w", ticketID, macroID, err)
 		}
 
 		return Ticket{
@@ -287,9 +619,64 @@ func (z *Client) ShowTicketAfterChanges(ctx context.Context, ticketID, macroID i
 			FollowerIDs:     r.Result.Ticket.FollowerIDs,
 			Status:          r.Result.Ticket.Status,
 			CustomFields:    r.Result.Ticket.CustomFields,
+			Priority:        r.Result.Ticket.Priority,
+			Type:            r.Result.Ticket.Type,
+			AssigneeID:      r.Result.Ticket.AssigneeID,
+			GroupID:         r.Result.Ticket.GroupID,
 		}, nil
 	}
 
 	//Zendesk api returns ticket.comment.public as string, not bool so needs custom unmarshalling
 	return unmarshal(body)
 }
+
+// SyncMacroPositions sets the order of all macros in one request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#update-many-macros
+func (z *Client) SyncMacroPositions(ctx context.Context, orderedIDs []int64) error {
+	if err := z.syncPositions(ctx, "macros", orderedIDs); err != nil {
+		return fmt.Errorf("sync macro positions: %w", err)
+	}
+	return nil
+}
+
+// GetMacroDefinitions gets the actions available to macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+func (z *Client) GetMacroDefinitions(ctx context.Context) (MacroDefinitions, error) {
+	var result struct {
+		Definitions MacroDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/macros/definitions.json")
+	if err != nil {
+		return MacroDefinitions{}, fmt.Errorf("get macro definitions: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroDefinitions{}, fmt.Errorf("get macro definitions: %w", err)
+	}
+	return result.Definitions, nil
+}
+
+// GetMacroCategories gets the categories of the active macros of the account,
+// as GetMacroCategory derives them from the titles
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-categories
+func (z *Client) GetMacroCategories(ctx context.Context) ([]string, error) {
+	var result struct {
+		Categories []string `json:"categories"`
+	}
+
+	body, err := z.get(ctx, "/macros/categories.json")
+	if err != nil {
+		return nil, fmt.Errorf("get macro categories: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("get macro categories: %w", err)
+	}
+	return result.Categories, nil
+}
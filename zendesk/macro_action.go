@@ -0,0 +1,46 @@
+package zendesk
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SetTags returns a MacroAction which replaces the tags of a ticket
+func SetTags(tags ...string) MacroAction {
+	return MacroAction{
+		Field: ActionFieldText(ActionFieldSetTags),
+		Value: strings.Join(tags, " "),
+	}
+}
+
+// SetPriority returns a MacroAction which sets the priority of a ticket
+func SetPriority(p TicketPriority) MacroAction {
+	return MacroAction{
+		Field: ActionFieldText(ActionFieldPriority),
+		Value: string(p),
+	}
+}
+
+// SetAssignee returns a MacroAction which assigns a ticket to the specified user
+func SetAssignee(id int64) MacroAction {
+	return MacroAction{
+		Field: ActionFieldText(ActionFieldAssigneeID),
+		Value: strconv.FormatInt(id, 10),
+	}
+}
+
+// AddComment returns MacroActions which add a comment to a ticket.
+// Zendesk needs one action for the comment body and another one for
+// its visibility, so both are returned.
+func AddComment(body string, public bool) []MacroAction {
+	return []MacroAction{
+		{
+			Field: ActionFieldText(ActionFieldCommentValue),
+			Value: body,
+		},
+		{
+			Field: ActionFieldText(ActionFieldCommentModeIsPublic),
+			Value: strconv.FormatBool(public),
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMacroActionBuilders(t *testing.T) {
+	cases := []struct {
+		action   MacroAction
+		expected MacroAction
+	}{
+		{SetTags("foo", "bar"), MacroAction{Field: "set_tags", Value: "foo bar"}},
+		{SetPriority(TicketPriorityHigh), MacroAction{Field: "priority", Value: "high"}},
+		{SetAssignee(123), MacroAction{Field: "assignee_id", Value: "123"}},
+	}
+
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.action, c.expected) {
+			t.Fatalf("expected %v, but got %v", c.expected, c.action)
+		}
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	expected := []MacroAction{
+		{Field: "comment_value", Value: "Thanks!"},
+		{Field: "comment_mode_is_public", Value: "false"},
+	}
+
+	if actions := AddComment("Thanks!", false); !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("expected %v, but got %v", expected, actions)
+	}
+}
@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// applyMacroConcurrency is how many tickets ApplyMacroToSearchResults updates at once.
+// Requests still go through the rate limiter and retries of the client.
+const applyMacroConcurrency = 4
+
+// ApplyMacroSummary is the result of ApplyMacroToSearchResults
+type ApplyMacroSummary struct {
+	// Matched is the number of tickets found by the query
+	Matched int
+	// Updated is the IDs of the tickets the macro was applied to
+	Updated []int64
+	// Failed is the errors of the tickets the macro couldn't be applied to
+	Failed map[int64]error
+}
+
+// ApplyMacroToSearchResults applies the macro to every ticket matching query.
+// Each ticket is updated to the result of ShowTicketAfterChanges, several tickets at once.
+// Failing tickets don't stop the others and are reported in ApplyMacroSummary.Failed.
+// An error is returned only when the search fails or ctx is done, along with the
+// summary of the tickets handled so far.
+func (z *Client) ApplyMacroToSearchResults(ctx context.Context, query string, macroID int64) (ApplyMacroSummary, error) {
+	summary := ApplyMacroSummary{Failed: make(map[int64]error)}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, applyMacroConcurrency)
+	)
+
+	apply := func(ticketID int64) {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		err := z.applyMacro(ctx, ticketID, macroID)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			summary.Failed[ticketID] = err
+			return
+		}
+		summary.Updated = append(summary.Updated, ticketID)
+	}
+
+	var searchErr error
+	opts := CursorPaginationOptions{}
+search:
+	for {
+		tickets, meta, err := z.ExportSearchTickets(ctx, query, &opts)
+		if err != nil {
+			searchErr = err
+			break
+		}
+
+		for _, ticket := range tickets {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				searchErr = ctx.Err()
+				break search
+			}
+
+			summary.Matched++
+			wg.Add(1)
+			go apply(ticket.ID)
+		}
+
+		if !meta.HasMore || meta.AfterCursor == "" {
+			break
+		}
+		opts.After = meta.AfterCursor
+	}
+	wg.Wait()
+
+	if searchErr != nil {
+		return summary, fmt.Errorf("apply macro %d to search results: %w", macroID, searchErr)
+	}
+	return summary, nil
+}
+
+// applyMacro updates the ticket to the result of applying the macro
+func (z *Client) applyMacro(ctx context.Context, ticketID, macroID int64) error {
+	ticket, err := z.ShowTicketAfterChanges(ctx, ticketID, macroID)
+	if err != nil {
+		return err
+	}
+
+	// Macros without a comment action return an empty comment, which can't be added
+	if ticket.Comment != nil && ticket.Comment.Body == "" {
+		ticket.Comment = nil
+	}
+
+	_, err = z.UpdateTicket(ctx, ticketID, ticket)
+	return err
+}
@@ -0,0 +1,84 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestApplyMacroToSearchResults(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		updates = make(map[string]map[string]interface{})
+	)
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/search/export.json":
+			if r.URL.Query().Get("page[after]") == "" {
+				w.Write([]byte(`{"results": [{"id": 1}, {"id": 2}], "meta": {"has_more": true, "after_cursor": "abc"}}`))
+				return
+			}
+			w.Write([]byte(`{"results": [{"id": 3}], "meta": {"has_more": false}}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/macros/360/apply"):
+			comment := `{"body": "", "public": "true"}`
+			if r.URL.Path == "/tickets/1/macros/360/apply" {
+				comment = `{"body": "We are on it", "public": "false"}`
+			}
+			w.Write([]byte(`{"result": {"ticket": {"ticket_form_id": 10, "status": "pending", "tags": ["escalated"], "comment": ` + comment + `}}}`))
+		case r.Method == http.MethodPut:
+			if r.URL.Path == "/tickets/3.json" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"error": "RecordInvalid"}`))
+				return
+			}
+
+			var body struct {
+				Ticket map[string]interface{} `json:"ticket"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			updates[r.URL.Path] = body.Ticket
+			mu.Unlock()
+			w.Write([]byte(`{"ticket": {}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	summary, err := client.ApplyMacroToSearchResults(ctx, "status:open tags:vip", 360)
+	if err != nil {
+		t.Fatalf("Failed to apply macro: %s", err)
+	}
+
+	sort.Slice(summary.Updated, func(i, j int) bool { return summary.Updated[i] < summary.Updated[j] })
+	if summary.Matched != 3 || len(summary.Updated) != 2 || summary.Updated[0] != 1 || summary.Updated[1] != 2 {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if len(summary.Failed) != 1 || summary.Failed[3] == nil {
+		t.Fatalf("expected ticket 3 to fail, but got %v", summary.Failed)
+	}
+
+	if updates["/tickets/1.json"]["status"] != "pending" || updates["/tickets/1.json"]["comment"] == nil {
+		t.Fatalf("unexpected update of ticket 1 %v", updates["/tickets/1.json"])
+	}
+	if _, ok := updates["/tickets/2.json"]["comment"]; ok {
+		t.Fatalf("expected no comment in update of ticket 2, but got %v", updates["/tickets/2.json"])
+	}
+}
+
+func TestApplyMacroToSearchResultsSearchError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "ticket.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.ApplyMacroToSearchResults(ctx, "status:open", 360); err == nil {
+		t.Fatal("expected an error when the search fails")
+	}
+}
@@ -0,0 +1,165 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// DiffMacros compares two macros and returns a human readable list of differences.
+// Fields managed by Zendesk (ID, URL, CreatedAt, UpdatedAt and Position) are ignored,
+// so a macro exported from one account can be compared with its copy in another.
+// Actions are compared regardless of their order.
+func DiffMacros(a, b Macro) []string {
+	var diffs []string
+
+	if a.Title != b.Title {
+		diffs = append(diffs, fmt.Sprintf("title: %q -> %q", a.Title, b.Title))
+	}
+
+	if a.Active != b.Active {
+		diffs = append(diffs, fmt.Sprintf("active: %t -> %t", a.Active, b.Active))
+	}
+
+	if !reflect.DeepEqual(a.Description, b.Description) {
+		diffs = append(diffs, fmt.Sprintf("description: %v -> %v", a.Description, b.Description))
+	}
+
+	if !reflect.DeepEqual(a.Restriction, b.Restriction) {
+		diffs = append(diffs, fmt.Sprintf("restriction: %v -> %v", a.Restriction, b.Restriction))
+	}
+
+	remaining := make(map[string]int)
+	for _, action := range b.Actions {
+		remaining[macroActionString(action)]++
+	}
+
+	for _, action := range a.Actions {
+		s := macroActionString(action)
+		if remaining[s] > 0 {
+			remaining[s]--
+			continue
+		}
+		diffs = append(diffs, "action removed: "+s)
+	}
+
+	for _, action := range b.Actions {
+		s := macroActionString(action)
+		if remaining[s] > 0 {
+			remaining[s]--
+			diffs = append(diffs, "action added: "+s)
+		}
+	}
+
+	return diffs
+}
+
+func macroActionString(action MacroAction) string {
+	return fmt.Sprintf("%s=%v", action.Field, action.Value)
+}
+
+// FieldChange is a field of a ticket which a macro changes.
+// Field is the JSON name of the field, or "custom_fields.{id}" for custom fields.
+// For "comment", Before is nil and After is the *TicketComment the macro adds.
+type FieldChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+// MacroChangeSummary returns the fields of the ticket which applying the macro would change,
+// with their current and new values. It doesn't actually change the ticket.
+func (z *Client) MacroChangeSummary(ctx context.Context, ticketID, macroID int64) ([]FieldChange, error) {
+	before, err := z.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("macro %d change summary of ticket %d: %w", macroID, ticketID, err)
+	}
+
+	after, err := z.ShowTicketAfterChanges(ctx, ticketID, macroID)
+	if err != nil {
+		return nil, fmt.Errorf("macro %d change summary of ticket %d: %w", macroID, ticketID, err)
+	}
+
+	return diffTicketChanges(before, after), nil
+}
+
+// diffTicketChanges compares a ticket with the result of ShowTicketAfterChanges.
+// Fields which the result doesn't have, i.e. which are zero in after, are not compared.
+func diffTicketChanges(before, after Ticket) []FieldChange {
+	var changes []FieldChange
+
+	if after.Subject != "" && after.Subject != before.Subject {
+		changes = append(changes, FieldChange{"subject", before.Subject, after.Subject})
+	}
+
+	if after.Status != "" && after.Status != before.Status {
+		changes = append(changes, FieldChange{"status", before.Status, after.Status})
+	}
+
+	if after.Priority != "" && after.Priority != before.Priority {
+		changes = append(changes, FieldChange{"priority", before.Priority, after.Priority})
+	}
+
+	if after.Type != "" && after.Type != before.Type {
+		changes = append(changes, FieldChange{"type", before.Type, after.Type})
+	}
+
+	if after.AssigneeID != 0 && after.AssigneeID != before.AssigneeID {
+		changes = append(changes, FieldChange{"assignee_id", before.AssigneeID, after.AssigneeID})
+	}
+
+	if after.GroupID != 0 && after.GroupID != before.GroupID {
+		changes = append(changes, FieldChange{"group_id", before.GroupID, after.GroupID})
+	}
+
+	if after.TicketFormID != 0 && after.TicketFormID != before.TicketFormID {
+		changes = append(changes, FieldChange{"ticket_form_id", before.TicketFormID, after.TicketFormID})
+	}
+
+	if after.Tags != nil && !sameStrings(before.Tags, after.Tags) {
+		changes = append(changes, FieldChange{"tags", before.Tags, after.Tags})
+	}
+
+	if after.CollaboratorIDs != nil && !sameIDs(before.CollaboratorIDs, after.CollaboratorIDs) {
+		changes = append(changes, FieldChange{"collaborator_ids", before.CollaboratorIDs, after.CollaboratorIDs})
+	}
+
+	if after.FollowerIDs != nil && !sameIDs(before.FollowerIDs, after.FollowerIDs) {
+		changes = append(changes, FieldChange{"follower_ids", before.FollowerIDs, after.FollowerIDs})
+	}
+
+	current := make(map[int64]interface{}, len(before.CustomFields))
+	for _, field := range before.CustomFields {
+		current[field.ID] = field.Value
+	}
+	for _, field := range after.CustomFields {
+		if !reflect.DeepEqual(current[field.ID], field.Value) {
+			changes = append(changes, FieldChange{fmt.Sprintf("custom_fields.%d", field.ID), current[field.ID], field.Value})
+		}
+	}
+
+	if after.Comment != nil && after.Comment.Body != "" {
+		changes = append(changes, FieldChange{"comment", nil, after.Comment})
+	}
+
+	return changes
+}
+
+// sameStrings reports whether a and b have the same elements regardless of their order
+func sameStrings(a, b []string) bool {
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	return len(x) == len(y) && (len(x) == 0 || reflect.DeepEqual(x, y))
+}
+
+// sameIDs reports whether a and b have the same elements regardless of their order
+func sameIDs(a, b []int64) bool {
+	x := append([]int64(nil), a...)
+	y := append([]int64(nil), b...)
+	sort.Slice(x, func(i, j int) bool { return x[i] < x[j] })
+	sort.Slice(y, func(i, j int) bool { return y[i] < y[j] })
+	return len(x) == len(y) && (len(x) == 0 || reflect.DeepEqual(x, y))
+}
@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffMacros(t *testing.T) {
+	a := Macro{
+		ID:        1,
+		Title:     "Close",
+		Active:    true,
+		CreatedAt: ZendeskTime{time.Now()},
+		Actions: []MacroAction{
+			{Field: "status", Value: "solved"},
+			{Field: "priority", Value: "low"},
+		},
+	}
+	b := Macro{
+		ID:       2,
+		Title:    "Close ticket",
+		Active:   false,
+		Position: 3,
+		Actions: []MacroAction{
+			{Field: "priority", Value: "low"},
+			{Field: "status", Value: "closed"},
+		},
+	}
+
+	expected := []string{
+		`title: "Close" -> "Close ticket"`,
+		"active: true -> false",
+		"action removed: status=solved",
+		"action added: status=closed",
+	}
+
+	diffs := DiffMacros(a, b)
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Fatalf("expected %v, but got %v", expected, diffs)
+	}
+}
+
+func TestDiffMacrosIgnoresServerManagedFields(t *testing.T) {
+	a := Macro{ID: 1, URL: "a", Position: 1, CreatedAt: ZendeskTime{time.Now()}, Title: "Same"}
+	b := Macro{ID: 2, URL: "b", Position: 2, UpdatedAt: ZendeskTime{time.Now()}, Title: "Same"}
+
+	if diffs := DiffMacros(a, b); len(diffs) != 0 {
+		t.Fatalf("expected no differences, but got %v", diffs)
+	}
+}
+
+func TestMacroChangeSummary(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/2.json":
+			w.Write([]byte(`{"ticket": {
+				"id": 2, "subject": "Help", "status": "open", "ticket_form_id": 10,
+				"priority": "normal", "type": "question", "assignee_id": 7, "group_id": 20,
+				"tags": ["vip", "billing"], "follower_ids": [5],
+				"custom_fields": [{"id": 100, "value": "low"}, {"id": 101, "value": null}]
+			}}`))
+		case "/tickets/2/macros/360/apply":
+			w.Write([]byte(`{"result": {"ticket": {
+				"subject": "Help", "status": "pending", "ticket_form_id": 10,
+				"priority": "high", "type": "question", "assignee_id": 8, "group_id": 21,
+				"tags": ["billing", "vip", "escalated"], "follower_ids": [5],
+				"comment": {"body": "We are on it", "public": "false"},
+				"custom_fields": [{"id": 100, "value": "high"}, {"id": 101, "value": null}]
+			}}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	changes, err := client.MacroChangeSummary(ctx, 2, 360)
+	if err != nil {
+		t.Fatalf("Failed to get macro change summary: %s", err)
+	}
+
+	fields := make([]string, len(changes))
+	for i, change := range changes {
+		fields[i] = change.Field
+	}
+	expected := []string{"status", "priority", "assignee_id", "group_id", "tags", "custom_fields.100", "comment"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("expected changes of %v, but got %v", expected, fields)
+	}
+
+	if changes[0].Before != "open" || changes[0].After != "pending" {
+		t.Fatalf("unexpected status change %+v", changes[0])
+	}
+	if changes[1].Before != "normal" || changes[1].After != "high" {
+		t.Fatalf("unexpected priority change %+v", changes[1])
+	}
+	if changes[2].Before != int64(7) || changes[2].After != int64(8) {
+		t.Fatalf("unexpected assignee change %+v", changes[2])
+	}
+	if changes[3].Before != int64(20) || changes[3].After != int64(21) {
+		t.Fatalf("unexpected group change %+v", changes[3])
+	}
+	if changes[5].Before != "low" || changes[5].After != "high" {
+		t.Fatalf("unexpected custom field change %+v", changes[5])
+	}
+	if comment, ok := changes[6].After.(*TicketComment); !ok || comment.Body != "We are on it" || *comment.Public {
+		t.Fatalf("unexpected comment change %+v", changes[6])
+	}
+}
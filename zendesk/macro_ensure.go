@@ -0,0 +1,88 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// macroSearchOptions are the options of the macro search API
+type macroSearchOptions struct {
+	PageOptions
+	Query string `url:"query"`
+}
+
+// EnsureMacro returns the macro titled macro.Title, creating it when there is none.
+// created reports whether the macro was created. Titles are matched exactly.
+// When another process creates the macro at the same time and Zendesk rejects
+// the create with 422 Unprocessable Entity, the macro it created is returned.
+func (z *Client) EnsureMacro(ctx context.Context, macro Macro) (Macro, bool, error) {
+	existing, found, err := z.findMacroByTitle(ctx, macro.Title)
+	if err != nil {
+		return Macro{}, false, fmt.Errorf("ensure macro %q: %w", macro.Title, err)
+	}
+	if found {
+		return existing, false, nil
+	}
+
+	created, err := z.CreateMacro(ctx, macro)
+	if err == nil {
+		return created, true, nil
+	}
+
+	var zErr Error
+	if !errors.As(err, &zErr) || zErr.Status() != http.StatusUnprocessableEntity {
+		return Macro{}, false, fmt.Errorf("ensure macro %q: %w", macro.Title, err)
+	}
+
+	existing, found, findErr := z.findMacroByTitle(ctx, macro.Title)
+	if findErr != nil || !found {
+		return Macro{}, false, fmt.Errorf("ensure macro %q: %w", macro.Title, err)
+	}
+	return existing, false, nil
+}
+
+// findMacroByTitle searches the macro titled title through every page of the macro search API
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#search-macros
+func (z *Client) findMacroByTitle(ctx context.Context, title string) (Macro, bool, error) {
+	opts := macroSearchOptions{
+		PageOptions: PageOptions{PerPage: maxPerPage, Page: 1},
+		Query:       title,
+	}
+
+	for {
+		var data struct {
+			Macros []Macro `json:"macros"`
+			Page
+		}
+
+		u, err := addOptions("/macros/search.json", opts)
+		if err != nil {
+			return Macro{}, false, err
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return Macro{}, false, err
+		}
+
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return Macro{}, false, err
+		}
+
+		for _, macro := range data.Macros {
+			if macro.Title == title {
+				return macro, true, nil
+			}
+		}
+
+		if !data.Page.HasNext() {
+			return Macro{}, false, nil
+		}
+		opts.Page++
+	}
+}
@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newEnsureMacroMockAPI(t *testing.T, searches []string, createStatus int, creates *int) *httptest.Server {
+	var search int
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/macros/search.json":
+			if r.URL.Query().Get("query") != "Close and redirect" {
+				t.Errorf("unexpected query %s", r.URL.Query().Get("query"))
+			}
+			w.Write([]byte(searches[search]))
+			search++
+		case r.Method == http.MethodPost && r.URL.Path == "/macros.json":
+			*creates++
+			w.WriteHeader(createStatus)
+			if createStatus == http.StatusCreated {
+				w.Write([]byte(`{"macro": {"id": 3, "title": "Close and redirect"}}`))
+				return
+			}
+			w.Write([]byte(`{"error": "RecordInvalid"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+}
+
+func TestEnsureMacroExisting(t *testing.T) {
+	var creates int
+	mockAPI := newEnsureMacroMockAPI(t, []string{
+		`{"macros": [{"id": 1, "title": "Close and redirect to help center"}], "next_page": "https://example.zendesk.com/api/v2/macros/search.json?page=2"}`,
+		`{"macros": [{"id": 2, "title": "Close and redirect"}], "next_page": null}`,
+	}, http.StatusCreated, &creates)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macro, created, err := client.EnsureMacro(ctx, Macro{Title: "Close and redirect"})
+	if err != nil {
+		t.Fatalf("Failed to ensure macro: %s", err)
+	}
+
+	if created || macro.ID != 2 || creates != 0 {
+		t.Fatalf("expected existing macro 2, but got %d (created: %v, creates: %d)", macro.ID, created, creates)
+	}
+}
+
+func TestEnsureMacroCreated(t *testing.T) {
+	var creates int
+	mockAPI := newEnsureMacroMockAPI(t, []string{
+		`{"macros": [], "next_page": null}`,
+	}, http.StatusCreated, &creates)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macro, created, err := client.EnsureMacro(ctx, Macro{Title: "Close and redirect"})
+	if err != nil {
+		t.Fatalf("Failed to ensure macro: %s", err)
+	}
+
+	if !created || macro.ID != 3 || creates != 1 {
+		t.Fatalf("expected created macro 3, but got %d (created: %v, creates: %d)", macro.ID, created, creates)
+	}
+}
+
+func TestEnsureMacroCreatedConcurrently(t *testing.T) {
+	var creates int
+	mockAPI := newEnsureMacroMockAPI(t, []string{
+		`{"macros": [], "next_page": null}`,
+		`{"macros": [{"id": 4, "title": "Close and redirect"}], "next_page": null}`,
+	}, http.StatusUnprocessableEntity, &creates)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macro, created, err := client.EnsureMacro(ctx, Macro{Title: "Close and redirect"})
+	if err != nil {
+		t.Fatalf("Failed to ensure macro: %s", err)
+	}
+
+	if created || macro.ID != 4 {
+		t.Fatalf("expected existing macro 4, but got %d (created: %v)", macro.ID, created)
+	}
+}
+
+func TestEnsureMacroInvalid(t *testing.T) {
+	var creates int
+	mockAPI := newEnsureMacroMockAPI(t, []string{
+		`{"macros": [], "next_page": null}`,
+		`{"macros": [], "next_page": null}`,
+	}, http.StatusUnprocessableEntity, &creates)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, _, err := client.EnsureMacro(ctx, Macro{Title: "Close and redirect"}); err == nil {
+		t.Fatal("expected an error when the macro is invalid")
+	}
+}
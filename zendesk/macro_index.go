@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MacroIndex searches macros by title without calling the API.
+// Titles are matched case-insensitively and results are sorted by title.
+// A MacroIndex is safe for concurrent use, including while it's refreshed.
+type MacroIndex struct {
+	mu     sync.RWMutex
+	titles []string // lowercased titles, sorted
+	macros []Macro  // macros in the order of titles
+}
+
+// NewMacroIndex builds an index of macros
+func NewMacroIndex(macros []Macro) *MacroIndex {
+	idx := &MacroIndex{}
+	idx.build(macros)
+	return idx
+}
+
+func (idx *MacroIndex) build(macros []Macro) {
+	sorted := make([]Macro, len(macros))
+	copy(sorted, macros)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Title) < strings.ToLower(sorted[j].Title)
+	})
+
+	titles := make([]string, len(sorted))
+	for i, macro := range sorted {
+		titles[i] = strings.ToLower(macro.Title)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.titles = titles
+	idx.macros = sorted
+}
+
+// Refresh replaces the macros of the index with the macros matching opts,
+// fetched from every page of GetMacros. The index is left unchanged on error.
+func (idx *MacroIndex) Refresh(ctx context.Context, z *Client, opts *MacroListOptions) error {
+	var macros []Macro
+	it := z.IterateMacros(opts)
+	for it.Next(ctx) {
+		macros = append(macros, it.Macro())
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("refresh macro index: %w", err)
+	}
+
+	idx.build(macros)
+	return nil
+}
+
+// Len returns the number of macros in the index
+func (idx *MacroIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.macros)
+}
+
+// Prefix returns the macros whose title starts with prefix
+func (idx *MacroIndex) Prefix(prefix string) []Macro {
+	prefix = strings.ToLower(prefix)
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	start := sort.SearchStrings(idx.titles, prefix)
+	end := start
+	for end < len(idx.titles) && strings.HasPrefix(idx.titles[end], prefix) {
+		end++
+	}
+
+	result := make([]Macro, end-start)
+	copy(result, idx.macros[start:end])
+	return result
+}
+
+// Search returns the macros whose title contains substr
+func (idx *MacroIndex) Search(substr string) []Macro {
+	substr = strings.ToLower(substr)
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var result []Macro
+	for i, title := range idx.titles {
+		if strings.Contains(title, substr) {
+			result = append(result, idx.macros[i])
+		}
+	}
+	return result
+}
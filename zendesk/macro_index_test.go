@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func macroIDs(macros []Macro) []int64 {
+	ids := make([]int64, len(macros))
+	for i, macro := range macros {
+		ids[i] = macro.ID
+	}
+	return ids
+}
+
+func TestMacroIndex(t *testing.T) {
+	idx := NewMacroIndex([]Macro{
+		{ID: 1, Title: "Close and redirect"},
+		{ID: 2, Title: "Billing::Refund"},
+		{ID: 3, Title: "close as duplicate"},
+		{ID: 4, Title: "Billing::Invoice"},
+	})
+
+	if idx.Len() != 4 {
+		t.Fatalf("expected length of index is 4, but got %d", idx.Len())
+	}
+
+	cases := []struct {
+		name   string
+		result []Macro
+		ids    []int64
+	}{
+		{"prefix", idx.Prefix("clo"), []int64{1, 3}},
+		{"prefix with category", idx.Prefix("billing::"), []int64{4, 2}},
+		{"prefix without match", idx.Prefix("refund"), []int64{}},
+		{"empty prefix", idx.Prefix(""), []int64{4, 2, 1, 3}},
+		{"search", idx.Search("RE"), []int64{2, 1}},
+		{"search without match", idx.Search("escalate"), []int64{}},
+	}
+
+	for _, c := range cases {
+		ids := macroIDs(c.result)
+		if len(ids) != len(c.ids) {
+			t.Fatalf("%s: expected %v, but got %v", c.name, c.ids, ids)
+		}
+		for i := range ids {
+			if ids[i] != c.ids[i] {
+				t.Fatalf("%s: expected %v, but got %v", c.name, c.ids, ids)
+			}
+		}
+	}
+}
+
+func TestMacroIndexRefresh(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macros.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	idx := NewMacroIndex(nil)
+	if err := idx.Refresh(ctx, client, nil); err != nil {
+		t.Fatalf("Failed to refresh macro index: %s", err)
+	}
+
+	if idx.Len() != 2 {
+		t.Fatalf("expected length of index is 2, but got %d", idx.Len())
+	}
+	if macros := idx.Prefix("assign"); len(macros) != 1 || macros[0].Title != "Assign priority tag" {
+		t.Fatalf("unexpected macros %v", macros)
+	}
+}
@@ -0,0 +1,173 @@
+package zendesk
+
+import "context"
+
+// MacroIterator goes through the macros of every page of GetMacros.
+// Pages are fetched lazily while Next is called:
+//
+//	it := client.IterateMacros(&zendesk.MacroListOptions{Active: "true"})
+//	for it.Next(ctx) {
+//		macro := it.Macro()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+//
+// A MacroIterator must not be used by several goroutines at once.
+type MacroIterator struct {
+	client *Client
+	opts   MacroListOptions
+	macros []Macro
+	index  int
+	last   bool
+	err    error
+
+	limit int
+	count int
+
+	depth  int
+	pages  chan macroPage
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type macroPage struct {
+	macros []Macro
+	last   bool
+	err    error
+}
+
+// IterateMacros returns an iterator over the macros matching opts.
+// It starts at opts.Page, or the first page when it is not set.
+func (z *Client) IterateMacros(opts *MacroListOptions) *MacroIterator {
+	it := &MacroIterator{client: z}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.Page == 0 {
+		it.opts.Page = 1
+	}
+
+	return it
+}
+
+// Prefetch makes the iterator fetch up to depth pages ahead in a goroutine
+// while the caller works on the current page. It must be called before Next.
+// The context passed to the first call of Next is used to fetch all pages.
+// Call Close when stopping before the iteration ends so the goroutine exits.
+func (it *MacroIterator) Prefetch(depth int) *MacroIterator {
+	it.depth = depth
+	return it
+}
+
+// Take makes the iterator stop after n macros, however many pages there are.
+// When opts.PerPage is not set, pages are fetched no larger than needed, and
+// Prefetch fetches no page past the one with the n-th macro.
+// It must be called before Next.
+func (it *MacroIterator) Take(n int) *MacroIterator {
+	it.limit = n
+	if it.opts.PerPage == 0 && n > 0 && n < maxPerPage {
+		it.opts.PerPage = n
+	}
+	return it
+}
+
+// Next advances to the next macro, fetching the next page when needed.
+// It returns false when there are no more macros or an error occurred.
+func (it *MacroIterator) Next(ctx context.Context) bool {
+	if it.limit > 0 && it.count >= it.limit {
+		it.Close()
+		return false
+	}
+
+	for it.index >= len(it.macros) {
+		if it.err != nil || it.last {
+			it.Close()
+			return false
+		}
+
+		var page macroPage
+		if it.depth > 0 {
+			if it.pages == nil {
+				it.startPrefetch(ctx)
+			}
+
+			select {
+			case page = <-it.pages:
+			case <-ctx.Done():
+				page.err = ctx.Err()
+			}
+		} else {
+			page = it.fetch(ctx)
+		}
+
+		it.macros = page.macros
+		it.index = 0
+		it.last = page.last
+		it.err = page.err
+	}
+
+	it.index++
+	it.count++
+	return true
+}
+
+// Macro returns the current macro
+func (it *MacroIterator) Macro() Macro {
+	return it.macros[it.index-1]
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *MacroIterator) Err() error {
+	return it.err
+}
+
+// Close stops prefetching pages and waits until no page is being fetched.
+// It's not needed without Prefetch, or once Next has returned false.
+func (it *MacroIterator) Close() {
+	if it.cancel != nil {
+		it.cancel()
+		<-it.done
+	}
+}
+
+// fetch gets the page at it.opts.Page and moves it.opts to the next page
+func (it *MacroIterator) fetch(ctx context.Context) macroPage {
+	macros, page, err := it.client.GetMacros(ctx, &it.opts)
+	if err != nil {
+		return macroPage{err: err}
+	}
+
+	it.opts.Page++
+	return macroPage{macros: macros, last: !page.HasNext()}
+}
+
+// startPrefetch starts a goroutine which sends pages to it.pages until
+// the last page, an error or the page reaching the limit of Take,
+// or until the iterator is closed
+func (it *MacroIterator) startPrefetch(ctx context.Context) {
+	ctx, it.cancel = context.WithCancel(ctx)
+	// the goroutine holds one page while it waits to send it,
+	// so depth pages ahead fit in a buffer of depth-1
+	it.pages = make(chan macroPage, it.depth-1)
+	it.done = make(chan struct{})
+	remaining := it.limit - it.count
+
+	go func() {
+		defer close(it.done)
+		for {
+			page := it.fetch(ctx)
+			select {
+			case it.pages <- page:
+			case <-ctx.Done():
+				return
+			}
+
+			remaining -= len(page.macros)
+			if page.err != nil || page.last || (it.limit > 0 && remaining <= 0) {
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,268 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMacroIterator(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"macros": [{"id": 1}, {"id": 2}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=2"}`,
+		"2": `{"macros": [{"id": 3}], "next_page": null}`,
+	}
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(pages[r.URL.Query().Get("page")]))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	it := client.IterateMacros(nil)
+	for it.Next(ctx) {
+		ids = append(ids, it.Macro().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate macros: %s", err)
+	}
+
+	if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+		t.Fatalf("Unexpected macro ids %v", ids)
+	}
+}
+
+func TestMacroIteratorError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "macros.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.IterateMacros(nil)
+	if it.Next(ctx) {
+		t.Fatal("Next should return false on error")
+	}
+
+	if it.Err() == nil {
+		t.Fatal("Err should return the error")
+	}
+}
+
+// newMacroPagesAPI serves pages of one macro each, waiting delay before responding
+func newMacroPagesAPI(pages int, delay time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page >= pages {
+			fmt.Fprintf(w, `{"macros": [{"id": %d}], "next_page": null}`, page)
+			return
+		}
+		fmt.Fprintf(w, `{"macros": [{"id": %d}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=%d"}`, page, page+1)
+	}))
+}
+
+func TestMacroIteratorPrefetch(t *testing.T) {
+	mockAPI := newMacroPagesAPI(5, 0)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	it := client.IterateMacros(nil).Prefetch(2)
+	for it.Next(ctx) {
+		ids = append(ids, it.Macro().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate macros: %s", err)
+	}
+
+	for i, id := range ids {
+		if id != int64(i+1) {
+			t.Fatalf("Unexpected macro ids %v", ids)
+		}
+	}
+	if len(ids) != 5 {
+		t.Fatalf("Unexpected macro ids %v", ids)
+	}
+}
+
+func TestMacroIteratorPrefetchError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "macros.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.IterateMacros(nil).Prefetch(2)
+	if it.Next(ctx) {
+		t.Fatal("Next should return false on error")
+	}
+
+	if it.Err() == nil {
+		t.Fatal("Err should return the error")
+	}
+}
+
+func TestMacroIteratorPrefetchClose(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"macros": [{"id": 1}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=2"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.IterateMacros(nil).Prefetch(2)
+	if !it.Next(ctx) {
+		t.Fatalf("Failed to iterate macros: %s", it.Err())
+	}
+	it.Close()
+
+	// Close waits for the prefetch goroutine, so no request is sent after it.
+	// One page is consumed and at most two are fetched ahead.
+	if n := atomic.LoadInt32(&requests); n > 3 {
+		t.Fatalf("Prefetch should stop after Close. requests: %d", n)
+	}
+}
+
+func TestMacroIteratorPrefetchDepth(t *testing.T) {
+	var requests int32
+	reached := make(chan struct{}, 1)
+	var expected int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == atomic.LoadInt32(&expected) {
+			reached <- struct{}{}
+		}
+		w.Write([]byte(`{"macros": [{"id": 1}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=2"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	for _, depth := range []int{1, 2, 3} {
+		atomic.StoreInt32(&requests, 0)
+		atomic.StoreInt32(&expected, int32(depth+1))
+
+		it := client.IterateMacros(nil).Prefetch(depth)
+		if !it.Next(ctx) {
+			t.Fatalf("Failed to iterate macros: %s", it.Err())
+		}
+
+		// the page being consumed and depth pages ahead
+		select {
+		case <-reached:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d requests with depth %d, but got %d", depth+1, depth, atomic.LoadInt32(&requests))
+		}
+		it.Close()
+
+		if n := atomic.LoadInt32(&requests); n != int32(depth+1) {
+			t.Fatalf("expected %d requests with depth %d, but got %d", depth+1, depth, n)
+		}
+	}
+}
+
+func benchmarkMacroIterator(b *testing.B, depth int) {
+	mockAPI := newMacroPagesAPI(10, 2*time.Millisecond)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	for i := 0; i < b.N; i++ {
+		it := client.IterateMacros(nil).Prefetch(depth)
+		for it.Next(ctx) {
+			// work on the macro as long as a page takes to arrive
+			time.Sleep(2 * time.Millisecond)
+		}
+		if err := it.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMacroIterator(b *testing.B) {
+	benchmarkMacroIterator(b, 0)
+}
+
+func BenchmarkMacroIteratorPrefetch(b *testing.B) {
+	benchmarkMacroIterator(b, 2)
+}
+
+func TestMacroIteratorTake(t *testing.T) {
+	var requests int32
+	var perPage string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		perPage = r.URL.Query().Get("per_page")
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		fmt.Fprintf(w, `{"macros": [{"id": %d}, {"id": %d}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=%d"}`,
+			page*2-1, page*2, page+1)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	it := client.IterateMacros(&MacroListOptions{PageOptions: PageOptions{PerPage: 2}}).Take(3)
+	for it.Next(ctx) {
+		ids = append(ids, it.Macro().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate macros: %s", err)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Fatalf("Unexpected macro ids %v", ids)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, but got %d", requests)
+	}
+	if perPage != "2" {
+		t.Fatalf("expected per_page to be kept, but got %s", perPage)
+	}
+}
+
+func TestMacroIteratorTakePrefetch(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		fmt.Fprintf(w, `{"macros": [{"id": %d}, {"id": %d}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=%d"}`,
+			page*2-1, page*2, page+1)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	it := client.IterateMacros(&MacroListOptions{PageOptions: PageOptions{PerPage: 2}}).Take(3).Prefetch(5)
+	for it.Next(ctx) {
+		ids = append(ids, it.Macro().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate macros: %s", err)
+	}
+	if len(ids) != 3 {
+		t.Fatalf("Unexpected macro ids %v", ids)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Fatalf("expected prefetch to stop at the page of the 3rd macro, but got %d requests", n)
+	}
+}
+
+func TestMacroIteratorTakeSetsPerPage(t *testing.T) {
+	var perPage string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perPage = r.URL.Query().Get("per_page")
+		w.Write([]byte(`{"macros": [{"id": 1}, {"id": 2}, {"id": 3}], "next_page": null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.IterateMacros(nil).Take(3)
+	for it.Next(ctx) {
+	}
+
+	if perPage != "3" {
+		t.Fatalf("expected per_page 3, but got %s", perPage)
+	}
+}
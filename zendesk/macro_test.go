@@ -1,9 +1,15 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetMacros(t *testing.T) {
@@ -94,6 +100,39 @@ func TestUpdateMacroFailure(t *testing.T) {
 	}
 }
 
+func TestDeactivateMacro(t *testing.T) {
+	for name, c := range map[string]struct {
+		call   func(*Client) (Macro, error)
+		active bool
+	}{
+		"deactivate": {func(client *Client) (Macro, error) { return client.DeactivateMacro(ctx, 2) }, false},
+		"activate":   {func(client *Client) (Macro, error) { return client.ActivateMacro(ctx, 2) }, true},
+	} {
+		var body map[string]map[string]interface{}
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/macros/2.json" {
+				t.Errorf("%s: unexpected request %s %s", name, r.Method, r.URL.Path)
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write(readFixture(filepath.Join(http.MethodPut, "macro.json")))
+		}))
+		client := newTestClient(mockAPI)
+
+		macro, err := c.call(client)
+		mockAPI.Close()
+		if err != nil {
+			t.Fatalf("%s: Failed to update macro: %s", name, err)
+		}
+		if macro.ID != 2 {
+			t.Fatalf("%s: unexpected macro %+v", name, macro)
+		}
+
+		if len(body["macro"]) != 1 || body["macro"]["active"] != c.active {
+			t.Fatalf("%s: unexpected request body %v", name, body)
+		}
+	}
+}
+
 func TestDeleteMacro(t *testing.T) {
 	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -106,3 +145,409 @@ func TestDeleteMacro(t *testing.T) {
 		t.Fatalf("Failed to delete macro field: %s", err)
 	}
 }
+
+func TestMacroUnmarshalKeepsUnknownFields(t *testing.T) {
+	data := []byte(`{"id": 1, "title": "Close", "new_field": {"enabled": true}, "another": 3}`)
+
+	var macro Macro
+	if err := json.Unmarshal(data, &macro); err != nil {
+		t.Fatalf("Failed to unmarshal macro: %s", err)
+	}
+
+	if macro.ID != 1 || macro.Title != "Close" {
+		t.Fatalf("Known fields were not decoded: %+v", macro)
+	}
+
+	if len(macro.Extra) != 2 {
+		t.Fatalf("Expected 2 unknown fields but got %d: %v", len(macro.Extra), macro.Extra)
+	}
+
+	if string(macro.Extra["new_field"]) != `{"enabled": true}` {
+		t.Fatalf("Unexpected raw value for new_field: %s", macro.Extra["new_field"])
+	}
+
+	out, err := json.Marshal(macro)
+	if err != nil {
+		t.Fatalf("Failed to marshal macro: %s", err)
+	}
+	if strings.Contains(string(out), "new_field") {
+		t.Fatalf("Unknown fields should not be sent back: %s", out)
+	}
+}
+
+func TestMacrosChangedSince(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"macros": [
+			{"id": 1, "updated_at": "2021-03-01T00:00:00Z"},
+			{"id": 2, "updated_at": "2021-02-01T00:00:00Z"}
+		], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=2"}`,
+		"2": `{"macros": [
+			{"id": 3, "updated_at": "2021-01-15T00:00:00Z"},
+			{"id": 4, "updated_at": "2020-12-01T00:00:00Z"}
+		], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=3"}`,
+	}
+
+	var requested []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort_by") != "updated_at" || q.Get("sort_order") != "desc" {
+			t.Errorf("Macros should be sorted by updated_at desc: %s", r.URL.RawQuery)
+			return
+		}
+		requested = append(requested, q.Get("page"))
+		w.Write([]byte(pages[q.Get("page")]))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, err := client.MacrosChangedSince(ctx, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Failed to get changed macros: %s", err)
+	}
+
+	if len(macros) != 3 || macros[2].ID != 3 {
+		t.Fatalf("Unexpected macros %v", macros)
+	}
+
+	if strings.Join(requested, ",") != "1,2" {
+		t.Fatalf("Paging should stop at the watermark. requested pages: %v", requested)
+	}
+}
+
+func TestMacroListOptionsTimeFilter(t *testing.T) {
+	opts := &MacroListOptions{
+		TimeFilter: TimeFilter{
+			CreatedAfter: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	u, err := addOptions("/macros.json", opts)
+	if err != nil {
+		t.Fatalf("Failed to add options: %s", err)
+	}
+
+	if !strings.Contains(u, "created_after=2021-04-01T00%3A00%3A00Z") {
+		t.Fatalf("created_after is not encoded in RFC3339: %s", u)
+	}
+
+	for _, key := range []string{"created_before", "updated_before", "updated_after"} {
+		if strings.Contains(u, key) {
+			t.Fatalf("zero %s should be omitted: %s", key, u)
+		}
+	}
+}
+
+func TestCloneMacro(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"macro": {
+				"id": 1,
+				"url": "https://example.zendesk.com/api/v2/macros/1.json",
+				"title": "Close",
+				"active": true,
+				"position": 3,
+				"created_at": "2019-09-16T02:17:38Z",
+				"updated_at": "2019-09-16T02:17:38Z",
+				"actions": []
+			}}`))
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("Failed to decode request body: %s", err)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"macro": {"id": 2, "title": "Close (copy)", "active": true}}`))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macro, err := client.CloneMacro(ctx, 1, "Close (copy)")
+	if err != nil {
+		t.Fatalf("Failed to clone macro: %s", err)
+	}
+
+	if macro.ID != 2 {
+		t.Fatalf("Returned macro does not have the expected ID 2. Macro id is %d", macro.ID)
+	}
+
+	sent := payload["macro"]
+	if sent["title"] != "Close (copy)" || sent["active"] != true {
+		t.Fatalf("Unexpected macro sent %v", sent)
+	}
+
+	for _, key := range []string{"id", "url", "position"} {
+		if _, ok := sent[key]; ok {
+			t.Fatalf("%s should not be copied: %v", key, sent)
+		}
+	}
+
+	if sent["created_at"] == "2019-09-16T02:17:38Z" || sent["updated_at"] == "2019-09-16T02:17:38Z" {
+		t.Fatalf("timestamps should not be copied: %v", sent)
+	}
+}
+
+func TestGetAllActiveMacros(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("active") != "true" || q.Get("only_viewable") != "true" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			return
+		}
+
+		if q.Get("page") == "1" {
+			w.Write([]byte(`{"macros": [{"id": 1}], "next_page": "https://example.zendesk.com/api/v2/macros.json?page=2"}`))
+			return
+		}
+		w.Write([]byte(`{"macros": [{"id": 2}], "next_page": null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, err := client.GetAllActiveMacros(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get active macros: %s", err)
+	}
+
+	if len(macros) != 2 {
+		t.Fatalf("Returned macros does not have the expected length 2. Macros length is %d", len(macros))
+	}
+}
+
+func TestGetMacrosByUsage(t *testing.T) {
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"macros": [{"id": 2, "usage_7d": 1}, {"id": 1, "usage_7d": 12}], "next_page": null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.GetMacrosByUsage(ctx, MacroUsage7d, &MacroListOptions{SortOrder: "asc"})
+	if err != nil {
+		t.Fatalf("Failed to get macros by usage: %s", err)
+	}
+
+	if query.Get("sort_by") != "usage_7d" || query.Get("include") != "usage_7d" || query.Get("sort_order") != "asc" {
+		t.Fatalf("Unexpected query %v", query)
+	}
+
+	if len(macros) != 2 || macros[0].Usage7d != 1 || macros[1].Usage7d != 12 {
+		t.Fatalf("Unexpected macros %v", macros)
+	}
+}
+
+func TestGetMacroSummaries(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macros.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	summaries, _, err := client.GetMacroSummaries(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get macro summaries: %s", err)
+	}
+
+	macros, _, err := client.GetMacros(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get macros: %s", err)
+	}
+
+	if len(summaries) != len(macros) {
+		t.Fatalf("expected %d summaries, but got %d", len(macros), len(summaries))
+	}
+
+	for i, macro := range macros {
+		expected := MacroSummary{ID: macro.ID, Title: macro.Title, Active: macro.Active}
+		if summaries[i] != expected {
+			t.Fatalf("expected %v, but got %v", expected, summaries[i])
+		}
+	}
+}
+
+func BenchmarkGetMacros(b *testing.B) {
+	mockAPI := newMockAPI(http.MethodGet, "macros.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		client.GetMacros(ctx, nil)
+	}
+}
+
+func BenchmarkGetMacroSummaries(b *testing.B) {
+	mockAPI := newMockAPI(http.MethodGet, "macros.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		client.GetMacroSummaries(ctx, nil)
+	}
+}
+
+func TestMacroRoundTrip(t *testing.T) {
+	var golden []json.RawMessage
+	for _, name := range []string{"GET/macro.json", "GET/macro_restricted.json", "POST/macro.json", "PUT/macro.json"} {
+		var data struct {
+			Macro json.RawMessage `json:"macro"`
+		}
+		if err := json.Unmarshal(readFixture(name), &data); err != nil {
+			t.Fatalf("Failed to read %s: %s", name, err)
+		}
+		golden = append(golden, data.Macro)
+	}
+
+	var list struct {
+		Macros []json.RawMessage `json:"macros"`
+	}
+	if err := json.Unmarshal(readFixture("GET/macros.json"), &list); err != nil {
+		t.Fatalf("Failed to read macros.json: %s", err)
+	}
+	golden = append(golden, list.Macros...)
+
+	for _, data := range golden {
+		var macro Macro
+		if err := json.Unmarshal(data, &macro); err != nil {
+			t.Fatalf("Failed to unmarshal macro %s: %s", data, err)
+		}
+
+		out, err := json.Marshal(macro)
+		if err != nil {
+			t.Fatalf("Failed to marshal macro %d: %s", macro.ID, err)
+		}
+
+		var before, after map[string]interface{}
+		if err := json.Unmarshal(data, &before); err != nil {
+			t.Fatalf("Failed to unmarshal macro %d: %s", macro.ID, err)
+		}
+		if err := json.Unmarshal(out, &after); err != nil {
+			t.Fatalf("Failed to unmarshal marshaled macro %d: %s", macro.ID, err)
+		}
+
+		for key, value := range before {
+			if _, ok := macro.Extra[key]; ok {
+				continue
+			}
+			if !reflect.DeepEqual(after[key], value) {
+				t.Errorf("macro %d: %s changed from %v to %v", macro.ID, key, value, after[key])
+			}
+		}
+
+		// fields which were not in the original JSON may only be added with their zero value
+		for key, value := range after {
+			if _, ok := before[key]; ok {
+				continue
+			}
+			if value != nil && !reflect.DeepEqual(value, false) && !reflect.DeepEqual(value, []interface{}{}) {
+				t.Errorf("macro %d: %s was added as %v", macro.ID, key, value)
+			}
+		}
+	}
+}
+
+func TestMacroAccess(t *testing.T) {
+	var data struct {
+		Macros []Macro `json:"macros"`
+	}
+	err := json.Unmarshal([]byte(`{"macros": [
+		{"id": 1, "restriction": null, "permissions": {"can_edit": true}},
+		{"id": 2, "restriction": {"type": "Group", "id": 360004077472, "ids": [360004077472, 360004077473]}},
+		{"id": 3, "restriction": {"type": "User", "id": 377922500013}}
+	]}`), &data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal macros: %s", err)
+	}
+
+	expected := []MacroAccess{
+		{Scope: MacroScopeAccount},
+		{Scope: MacroScopeGroup, GroupIDs: []int64{360004077472, 360004077473}},
+		{Scope: MacroScopePersonal, UserID: 377922500013},
+	}
+	for i, macro := range data.Macros {
+		if access := macro.Access(); !reflect.DeepEqual(access, expected[i]) {
+			t.Fatalf("expected access of macro %d is %v, but got %v", macro.ID, expected[i], access)
+		}
+	}
+
+	if data.Macros[0].Permissions == nil || !data.Macros[0].Permissions.CanEdit {
+		t.Fatalf("Unexpected permissions %v", data.Macros[0].Permissions)
+	}
+}
+
+func TestGetMacroCategory(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{"Billing::Refunds::Approve", "Billing"},
+		{"Triage :: Escalate", "Triage"},
+		{"Close and redirect to topics", ""},
+	}
+
+	for _, tt := range tests {
+		if category := GetMacroCategory(Macro{Title: tt.title}); category != tt.expected {
+			t.Fatalf("expected category of %q is %q, but got %q", tt.title, tt.expected, category)
+		}
+	}
+}
+
+func TestMacroCategoryDecoded(t *testing.T) {
+	var macro Macro
+	if err := json.Unmarshal([]byte(`{"id": 1, "title": "Billing::Refunds::Approve"}`), &macro); err != nil {
+		t.Fatalf("Failed to decode macro: %s", err)
+	}
+	if macro.Category != "Billing" {
+		t.Fatalf("expected category Billing, but got %q", macro.Category)
+	}
+
+	data, err := json.Marshal(macro)
+	if err != nil {
+		t.Fatalf("Failed to encode macro: %s", err)
+	}
+	if strings.Contains(string(data), "Billing\"") {
+		t.Fatalf("expected category not to be sent, but got %s", data)
+	}
+}
+
+func TestGetMacroCategories(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/categories.json" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"categories": ["Billing", "Triage"]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.GetMacroCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro categories: %s", err)
+	}
+
+	if !reflect.DeepEqual(categories, []string{"Billing", "Triage"}) {
+		t.Fatalf("unexpected categories %v", categories)
+	}
+}
+
+func TestGetMacroDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetMacroDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro definitions: %s", err)
+	}
+
+	if len(definitions.Actions) != 2 {
+		t.Fatalf("expected length of actions is 2, but got %d", len(definitions.Actions))
+	}
+
+	status := definitions.Actions[0]
+	if status.Subject != "status" || status.Type != "list" || len(status.Values) != 2 || status.Values[1].Value != "solved" {
+		t.Fatalf("unexpected action %+v", status)
+	}
+}
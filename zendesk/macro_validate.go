@@ -0,0 +1,142 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MacroValidationError is returned by Macro.Validate with all problems found.
+// Warnings are about things which may be valid, such as action fields
+// unknown to this package. They are reported along with Errors; use
+// Macro.ValidationWarnings to get them when there are no errors.
+type MacroValidationError struct {
+	Errors   []string
+	Warnings []string
+}
+
+// Error lists the errors and warnings
+func (e *MacroValidationError) Error() string {
+	problems := make([]string, 0, len(e.Errors)+len(e.Warnings))
+	problems = append(problems, e.Errors...)
+	for _, w := range e.Warnings {
+		problems = append(problems, "warning: "+w)
+	}
+	return "invalid macro: " + strings.Join(problems, "; ")
+}
+
+// Validate checks the macro without calling the API. It reports an empty title,
+// no actions, actions without a field and a malformed Restriction as errors,
+// which are returned at once in a *MacroValidationError along with the warnings.
+// It returns nil when there are warnings only, as the macro can still be created.
+func (m Macro) Validate() error {
+	verr := m.validate()
+	if len(verr.Errors) == 0 {
+		return nil
+	}
+	return verr
+}
+
+// ValidationWarnings returns the action fields of the macro which are neither
+// ActionFieldText nor custom fields. They may still be valid.
+func (m Macro) ValidationWarnings() []string {
+	return m.validate().Warnings
+}
+
+func (m Macro) validate() *MacroValidationError {
+	verr := &MacroValidationError{}
+
+	if strings.TrimSpace(m.Title) == "" {
+		verr.Errors = append(verr.Errors, "title is empty")
+	}
+
+	if len(m.Actions) == 0 {
+		verr.Errors = append(verr.Errors, "no actions")
+	}
+
+	for i, action := range m.Actions {
+		switch {
+		case action.Field == "":
+			verr.Errors = append(verr.Errors, fmt.Sprintf("action %d has no field", i))
+		case !knownActionField(action.Field):
+			verr.Warnings = append(verr.Warnings, fmt.Sprintf("action %d has unknown field %q", i, action.Field))
+		}
+	}
+
+	if problem := validateRestriction(m.Restriction); problem != "" {
+		verr.Errors = append(verr.Errors, problem)
+	}
+	return verr
+}
+
+func knownActionField(field string) bool {
+	if strings.HasPrefix(field, "custom_fields_") {
+		return true
+	}
+	for _, text := range actionFieldText {
+		if field == text {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRestriction returns the problem of restriction, or "" when it's well-formed
+func validateRestriction(restriction interface{}) string {
+	if restriction == nil {
+		return ""
+	}
+
+	var r map[string]interface{}
+	switch restriction := restriction.(type) {
+	case map[string]interface{}:
+		r = restriction
+	case map[interface{}]interface{}:
+		// as decoded by gopkg.in/yaml.v2
+		r = make(map[string]interface{}, len(restriction))
+		for k, v := range restriction {
+			r[fmt.Sprint(k)] = v
+		}
+	default:
+		return fmt.Sprintf("restriction must be an object, but is %T", restriction)
+	}
+	if len(r) == 0 {
+		return ""
+	}
+
+	switch r["type"] {
+	case "User":
+		if !isID(r["id"]) {
+			return "restriction of type User needs the user id"
+		}
+	case "Group":
+		ids, _ := r["ids"].([]interface{})
+		for _, id := range ids {
+			if !isID(id) {
+				return fmt.Sprintf("restriction has invalid group id %v", id)
+			}
+		}
+		if len(ids) == 0 && !isID(r["id"]) {
+			return "restriction of type Group needs the group ids"
+		}
+	default:
+		return fmt.Sprintf("restriction has unknown type %v, which must be User or Group", r["type"])
+	}
+	return ""
+}
+
+// isID reports whether v is a positive number, as decoded from JSON or YAML
+func isID(v interface{}) bool {
+	switch n := v.(type) {
+	case float64:
+		return n > 0
+	case int:
+		return n > 0
+	case int64:
+		return n > 0
+	case json.Number:
+		i, err := n.Int64()
+		return err == nil && i > 0
+	}
+	return false
+}
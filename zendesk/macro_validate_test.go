@@ -0,0 +1,83 @@
+package zendesk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMacroValidate(t *testing.T) {
+	valid := Macro{
+		Title:       "Close",
+		Actions:     []MacroAction{{Field: "status", Value: "solved"}, {Field: "custom_fields_360", Value: "done"}},
+		Restriction: map[string]interface{}{"type": "Group", "ids": []interface{}{float64(360), 361}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected macro to be valid, but got %s", err)
+	}
+	if warnings := valid.ValidationWarnings(); len(warnings) != 0 {
+		t.Fatalf("expected no warnings, but got %v", warnings)
+	}
+
+	if err := (Macro{Title: "Close", Actions: []MacroAction{SetPriority(TicketPriorityHigh)}}).Validate(); err != nil {
+		t.Fatalf("expected macro without restriction to be valid, but got %s", err)
+	}
+
+	yamlRestriction := Macro{
+		Title:       "Close",
+		Actions:     []MacroAction{{Field: "status", Value: "solved"}},
+		Restriction: map[interface{}]interface{}{"type": "User", "id": 360},
+	}
+	if err := yamlRestriction.Validate(); err != nil {
+		t.Fatalf("expected macro with restriction decoded from YAML to be valid, but got %s", err)
+	}
+}
+
+func TestMacroValidateErrors(t *testing.T) {
+	m := Macro{
+		Title:       " ",
+		Actions:     []MacroAction{{Field: ""}, {Field: "statuss", Value: "solved"}},
+		Restriction: map[string]interface{}{"type": "Team", "id": float64(1)},
+	}
+
+	var verr *MacroValidationError
+	if err := m.Validate(); !errors.As(err, &verr) {
+		t.Fatalf("expected a MacroValidationError, but got %v", err)
+	}
+
+	if len(verr.Errors) != 3 {
+		t.Fatalf("expected 3 errors, but got %v", verr.Errors)
+	}
+	if len(verr.Warnings) != 1 {
+		t.Fatalf("expected 1 warning, but got %v", verr.Warnings)
+	}
+}
+
+func TestMacroValidateRestriction(t *testing.T) {
+	cases := map[string]interface{}{
+		"not an object":     "Group",
+		"user without id":   map[string]interface{}{"type": "User"},
+		"group without ids": map[string]interface{}{"type": "Group", "ids": []interface{}{}},
+		"invalid group id":  map[string]interface{}{"type": "Group", "ids": []interface{}{"360"}},
+		"yaml without id":   map[interface{}]interface{}{"type": "User"},
+	}
+
+	for name, restriction := range cases {
+		m := Macro{Title: "Close", Actions: []MacroAction{{Field: "status", Value: "solved"}}, Restriction: restriction}
+
+		var verr *MacroValidationError
+		if err := m.Validate(); !errors.As(err, &verr) || len(verr.Errors) != 1 {
+			t.Fatalf("%s: expected 1 error, but got %v", name, err)
+		}
+	}
+}
+
+func TestMacroValidateWarningsOnly(t *testing.T) {
+	m := Macro{Title: "Follow", Actions: []MacroAction{{Field: "follower", Value: "current_user"}, {Field: "folower", Value: "current_user"}}}
+
+	if err := m.Validate(); err != nil {
+		t.Fatalf("expected no error for warnings only, but got %s", err)
+	}
+	if warnings := m.ValidationWarnings(); len(warnings) != 1 {
+		t.Fatalf("expected 1 warning for the misspelled field only, but got %v", warnings)
+	}
+}
@@ -1,15 +1,18 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nukosuke/go-zendesk/zendesk (interfaces: API)
+// Source: github.com/lewisje1991/go-zendesk/zendesk (interfaces: API)
 
 // Package mock is a generated GoMock package.
 package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	regexp "regexp"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
-	zendesk "github.com/nukosuke/go-zendesk/zendesk"
+	zendesk "github.com/lewisje1991/go-zendesk/zendesk"
 )
 
 // Client is a mock of API interface.
@@ -35,6 +38,21 @@ func (m *Client) EXPECT() *ClientMockRecorder {
 	return m.recorder
 }
 
+// ActivateMacro mocks base method.
+func (m *Client) ActivateMacro(arg0 context.Context, arg1 int64) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateMacro", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateMacro indicates an expected call of ActivateMacro.
+func (mr *ClientMockRecorder) ActivateMacro(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateMacro", reflect.TypeOf((*Client)(nil).ActivateMacro), arg0, arg1)
+}
+
 // AddOrganizationTags mocks base method.
 func (m *Client) AddOrganizationTags(arg0 context.Context, arg1 int64, arg2 []zendesk.Tag) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +98,126 @@ func (mr *ClientMockRecorder) AddUserTags(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*Client)(nil).AddUserTags), arg0, arg1, arg2)
 }
 
+// ApplyMacroToSearchResults mocks base method.
+func (m *Client) ApplyMacroToSearchResults(arg0 context.Context, arg1 string, arg2 int64) (zendesk.ApplyMacroSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyMacroToSearchResults", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ApplyMacroSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyMacroToSearchResults indicates an expected call of ApplyMacroToSearchResults.
+func (mr *ClientMockRecorder) ApplyMacroToSearchResults(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyMacroToSearchResults", reflect.TypeOf((*Client)(nil).ApplyMacroToSearchResults), arg0, arg1, arg2)
+}
+
+// AssignTicket mocks base method.
+func (m *Client) AssignTicket(arg0 context.Context, arg1, arg2 int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignTicket indicates an expected call of AssignTicket.
+func (mr *ClientMockRecorder) AssignTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTicket", reflect.TypeOf((*Client)(nil).AssignTicket), arg0, arg1, arg2)
+}
+
+// AutocompleteOrganizations mocks base method.
+func (m *Client) AutocompleteOrganizations(arg0 context.Context, arg1 string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteOrganizations", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteOrganizations indicates an expected call of AutocompleteOrganizations.
+func (mr *ClientMockRecorder) AutocompleteOrganizations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteOrganizations", reflect.TypeOf((*Client)(nil).AutocompleteOrganizations), arg0, arg1)
+}
+
+// AutocompleteProblems mocks base method.
+func (m *Client) AutocompleteProblems(arg0 context.Context, arg1 string) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteProblems", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteProblems indicates an expected call of AutocompleteProblems.
+func (mr *ClientMockRecorder) AutocompleteProblems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteProblems", reflect.TypeOf((*Client)(nil).AutocompleteProblems), arg0, arg1)
+}
+
+// ClearTicketDueAt mocks base method.
+func (m *Client) ClearTicketDueAt(arg0 context.Context, arg1 int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearTicketDueAt", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearTicketDueAt indicates an expected call of ClearTicketDueAt.
+func (mr *ClientMockRecorder) ClearTicketDueAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearTicketDueAt", reflect.TypeOf((*Client)(nil).ClearTicketDueAt), arg0, arg1)
+}
+
+// CloneMacro mocks base method.
+func (m *Client) CloneMacro(arg0 context.Context, arg1 int64, arg2 string) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneMacro", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneMacro indicates an expected call of CloneMacro.
+func (mr *ClientMockRecorder) CloneMacro(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneMacro", reflect.TypeOf((*Client)(nil).CloneMacro), arg0, arg1, arg2)
+}
+
+// CloseTickets mocks base method.
+func (m *Client) CloseTickets(arg0 context.Context, arg1 []int64, arg2 bool) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseTickets indicates an expected call of CloseTickets.
+func (mr *ClientMockRecorder) CloseTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTickets", reflect.TypeOf((*Client)(nil).CloseTickets), arg0, arg1, arg2)
+}
+
+// CountOrganizationTickets mocks base method.
+func (m *Client) CountOrganizationTickets(arg0 context.Context, arg1 int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrganizationTickets", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrganizationTickets indicates an expected call of CountOrganizationTickets.
+func (mr *ClientMockRecorder) CountOrganizationTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrganizationTickets", reflect.TypeOf((*Client)(nil).CountOrganizationTickets), arg0, arg1)
+}
+
 // CreateAutomation mocks base method.
 func (m *Client) CreateAutomation(arg0 context.Context, arg1 zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +263,21 @@ func (mr *ClientMockRecorder) CreateDynamicContentItem(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDynamicContentItem", reflect.TypeOf((*Client)(nil).CreateDynamicContentItem), arg0, arg1)
 }
 
+// CreateFollowupTicket mocks base method.
+func (m *Client) CreateFollowupTicket(arg0 context.Context, arg1 int64, arg2 zendesk.Ticket) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowupTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFollowupTicket indicates an expected call of CreateFollowupTicket.
+func (mr *ClientMockRecorder) CreateFollowupTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowupTicket", reflect.TypeOf((*Client)(nil).CreateFollowupTicket), arg0, arg1, arg2)
+}
+
 // CreateGroup mocks base method.
 func (m *Client) CreateGroup(arg0 context.Context, arg1 zendesk.Group) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +308,21 @@ func (mr *ClientMockRecorder) CreateMacro(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), arg0, arg1)
 }
 
+// CreateOrUpdateTicketByExternalID mocks base method.
+func (m *Client) CreateOrUpdateTicketByExternalID(arg0 context.Context, arg1 zendesk.Ticket) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateTicketByExternalID", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateTicketByExternalID indicates an expected call of CreateOrUpdateTicketByExternalID.
+func (mr *ClientMockRecorder) CreateOrUpdateTicketByExternalID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateTicketByExternalID", reflect.TypeOf((*Client)(nil).CreateOrUpdateTicketByExternalID), arg0, arg1)
+}
+
 // CreateOrUpdateUser mocks base method.
 func (m *Client) CreateOrUpdateUser(arg0 context.Context, arg1 zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +368,21 @@ func (mr *ClientMockRecorder) CreateSLAPolicy(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), arg0, arg1)
 }
 
+// CreateSideConversation mocks base method.
+func (m *Client) CreateSideConversation(arg0 context.Context, arg1 int64, arg2 zendesk.Message) (zendesk.SideConversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSideConversation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.SideConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSideConversation indicates an expected call of CreateSideConversation.
+func (mr *ClientMockRecorder) CreateSideConversation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSideConversation", reflect.TypeOf((*Client)(nil).CreateSideConversation), arg0, arg1, arg2)
+}
+
 // CreateTarget mocks base method.
 func (m *Client) CreateTarget(arg0 context.Context, arg1 zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +473,21 @@ func (mr *ClientMockRecorder) CreateTrigger(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*Client)(nil).CreateTrigger), arg0, arg1)
 }
 
+// CreateTriggerCategory mocks base method.
+func (m *Client) CreateTriggerCategory(arg0 context.Context, arg1 zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTriggerCategory", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTriggerCategory indicates an expected call of CreateTriggerCategory.
+func (mr *ClientMockRecorder) CreateTriggerCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTriggerCategory", reflect.TypeOf((*Client)(nil).CreateTriggerCategory), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *Client) CreateUser(arg0 context.Context, arg1 zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +518,21 @@ func (mr *ClientMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*Client)(nil).CreateWebhook), arg0, arg1)
 }
 
+// DeactivateMacro mocks base method.
+func (m *Client) DeactivateMacro(arg0 context.Context, arg1 int64) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateMacro", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateMacro indicates an expected call of DeactivateMacro.
+func (mr *ClientMockRecorder) DeactivateMacro(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateMacro", reflect.TypeOf((*Client)(nil).DeactivateMacro), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *Client) Delete(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -432,6 +645,20 @@ func (mr *ClientMockRecorder) DeleteSLAPolicy(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), arg0, arg1)
 }
 
+// DeleteSideConversationAttachment mocks base method.
+func (m *Client) DeleteSideConversationAttachment(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSideConversationAttachment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSideConversationAttachment indicates an expected call of DeleteSideConversationAttachment.
+func (mr *ClientMockRecorder) DeleteSideConversationAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSideConversationAttachment", reflect.TypeOf((*Client)(nil).DeleteSideConversationAttachment), arg0, arg1)
+}
+
 // DeleteTarget mocks base method.
 func (m *Client) DeleteTarget(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -502,6 +729,20 @@ func (mr *ClientMockRecorder) DeleteTrigger(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), arg0, arg1)
 }
 
+// DeleteTriggerCategory mocks base method.
+func (m *Client) DeleteTriggerCategory(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTriggerCategory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTriggerCategory indicates an expected call of DeleteTriggerCategory.
+func (mr *ClientMockRecorder) DeleteTriggerCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTriggerCategory", reflect.TypeOf((*Client)(nil).DeleteTriggerCategory), arg0, arg1)
+}
+
 // DeleteUpload mocks base method.
 func (m *Client) DeleteUpload(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -516,6 +757,21 @@ func (mr *ClientMockRecorder) DeleteUpload(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), arg0, arg1)
 }
 
+// DeleteUser mocks base method.
+func (m *Client) DeleteUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *ClientMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Client)(nil).DeleteUser), arg0, arg1)
+}
+
 // DeleteWebhook mocks base method.
 func (m *Client) DeleteWebhook(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -530,6 +786,38 @@ func (mr *ClientMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), arg0, arg1)
 }
 
+// EnsureMacro mocks base method.
+func (m *Client) EnsureMacro(arg0 context.Context, arg1 zendesk.Macro) (zendesk.Macro, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureMacro", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnsureMacro indicates an expected call of EnsureMacro.
+func (mr *ClientMockRecorder) EnsureMacro(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureMacro", reflect.TypeOf((*Client)(nil).EnsureMacro), arg0, arg1)
+}
+
+// ExportSearchTickets mocks base method.
+func (m *Client) ExportSearchTickets(arg0 context.Context, arg1 string, arg2 *zendesk.CursorPaginationOptions) ([]zendesk.Ticket, zendesk.CursorPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSearchTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExportSearchTickets indicates an expected call of ExportSearchTickets.
+func (mr *ClientMockRecorder) ExportSearchTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSearchTickets", reflect.TypeOf((*Client)(nil).ExportSearchTickets), arg0, arg1, arg2)
+}
+
 // Get mocks base method.
 func (m *Client) Get(arg0 context.Context, arg1 string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -545,6 +833,51 @@ func (mr *ClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), arg0, arg1)
 }
 
+// GetAccountSettings mocks base method.
+func (m *Client) GetAccountSettings(arg0 context.Context) (zendesk.AccountSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSettings", arg0)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSettings indicates an expected call of GetAccountSettings.
+func (mr *ClientMockRecorder) GetAccountSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettings", reflect.TypeOf((*Client)(nil).GetAccountSettings), arg0)
+}
+
+// GetAgentSkills mocks base method.
+func (m *Client) GetAgentSkills(arg0 context.Context, arg1 int64) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentSkills", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentSkills indicates an expected call of GetAgentSkills.
+func (mr *ClientMockRecorder) GetAgentSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentSkills", reflect.TypeOf((*Client)(nil).GetAgentSkills), arg0, arg1)
+}
+
+// GetAllActiveMacros mocks base method.
+func (m *Client) GetAllActiveMacros(arg0 context.Context) ([]zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllActiveMacros", arg0)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllActiveMacros indicates an expected call of GetAllActiveMacros.
+func (mr *ClientMockRecorder) GetAllActiveMacros(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllActiveMacros", reflect.TypeOf((*Client)(nil).GetAllActiveMacros), arg0)
+}
+
 // GetAllTicketAudits mocks base method.
 func (m *Client) GetAllTicketAudits(arg0 context.Context, arg1 zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
 	m.ctrl.T.Helper()
@@ -561,6 +894,67 @@ func (mr *ClientMockRecorder) GetAllTicketAudits(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), arg0, arg1)
 }
 
+// GetAppInstallation mocks base method.
+func (m *Client) GetAppInstallation(arg0 context.Context, arg1 int64) (zendesk.AppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppInstallation", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.AppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppInstallation indicates an expected call of GetAppInstallation.
+func (mr *ClientMockRecorder) GetAppInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppInstallation", reflect.TypeOf((*Client)(nil).GetAppInstallation), arg0, arg1)
+}
+
+// GetAppInstallations mocks base method.
+func (m *Client) GetAppInstallations(arg0 context.Context) ([]zendesk.AppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppInstallations", arg0)
+	ret0, _ := ret[0].([]zendesk.AppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppInstallations indicates an expected call of GetAppInstallations.
+func (mr *ClientMockRecorder) GetAppInstallations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppInstallations", reflect.TypeOf((*Client)(nil).GetAppInstallations), arg0)
+}
+
+// GetAppRequirements mocks base method.
+func (m *Client) GetAppRequirements(arg0 context.Context, arg1 int64) ([]zendesk.AppRequirement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppRequirements", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.AppRequirement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppRequirements indicates an expected call of GetAppRequirements.
+func (mr *ClientMockRecorder) GetAppRequirements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppRequirements", reflect.TypeOf((*Client)(nil).GetAppRequirements), arg0, arg1)
+}
+
+// GetAssignableGroups mocks base method.
+func (m *Client) GetAssignableGroups(arg0 context.Context, arg1 *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroups", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroups indicates an expected call of GetAssignableGroups.
+func (mr *ClientMockRecorder) GetAssignableGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroups", reflect.TypeOf((*Client)(nil).GetAssignableGroups), arg0, arg1)
+}
+
 // GetAttachment mocks base method.
 func (m *Client) GetAttachment(arg0 context.Context, arg1 int64) (zendesk.Attachment, error) {
 	m.ctrl.T.Helper()
@@ -622,6 +1016,21 @@ func (mr *ClientMockRecorder) GetBrand(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), arg0, arg1)
 }
 
+// GetCurrentUser mocks base method.
+func (m *Client) GetCurrentUser(arg0 context.Context) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", arg0)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *ClientMockRecorder) GetCurrentUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*Client)(nil).GetCurrentUser), arg0)
+}
+
 // GetCustomRoles mocks base method.
 func (m *Client) GetCustomRoles(arg0 context.Context) ([]zendesk.CustomRole, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +1046,37 @@ func (mr *ClientMockRecorder) GetCustomRoles(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), arg0)
 }
 
+// GetDeletedUser mocks base method.
+func (m *Client) GetDeletedUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedUser indicates an expected call of GetDeletedUser.
+func (mr *ClientMockRecorder) GetDeletedUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUser", reflect.TypeOf((*Client)(nil).GetDeletedUser), arg0, arg1)
+}
+
+// GetDeletedUsers mocks base method.
+func (m *Client) GetDeletedUsers(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsers", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsers indicates an expected call of GetDeletedUsers.
+func (mr *ClientMockRecorder) GetDeletedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsers", reflect.TypeOf((*Client)(nil).GetDeletedUsers), arg0, arg1)
+}
+
 // GetDynamicContentItem mocks base method.
 func (m *Client) GetDynamicContentItem(arg0 context.Context, arg1 int64) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -683,11 +1123,26 @@ func (mr *ClientMockRecorder) GetGroup(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), arg0, arg1)
 }
 
-// GetGroupMemberships mocks base method.
-func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// GetGroupAgents mocks base method.
+func (m *Client) GetGroupAgents(arg0 context.Context, arg1 int64) ([]zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetGroupAgents", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupAgents indicates an expected call of GetGroupAgents.
+func (mr *ClientMockRecorder) GetGroupAgents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupAgents", reflect.TypeOf((*Client)(nil).GetGroupAgents), arg0, arg1)
+}
+
+// GetGroupMemberships mocks base method.
+func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -715,6 +1170,53 @@ func (mr *ClientMockRecorder) GetGroups(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), arg0, arg1)
 }
 
+// GetIncrementalTicketMetricEvents mocks base method.
+func (m *Client) GetIncrementalTicketMetricEvents(arg0 context.Context, arg1 *zendesk.TicketMetricEventListOptions) ([]zendesk.MetricEvent, zendesk.IncrementalPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketMetricEvents", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.MetricEvent)
+	ret1, _ := ret[1].(zendesk.IncrementalPage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIncrementalTicketMetricEvents indicates an expected call of GetIncrementalTicketMetricEvents.
+func (mr *ClientMockRecorder) GetIncrementalTicketMetricEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketMetricEvents", reflect.TypeOf((*Client)(nil).GetIncrementalTicketMetricEvents), arg0, arg1)
+}
+
+// GetIncrementalTickets mocks base method.
+func (m *Client) GetIncrementalTickets(arg0 context.Context, arg1 zendesk.CursorOption) ([]zendesk.Ticket, zendesk.IncrementalCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTickets", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.IncrementalCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIncrementalTickets indicates an expected call of GetIncrementalTickets.
+func (mr *ClientMockRecorder) GetIncrementalTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTickets", reflect.TypeOf((*Client)(nil).GetIncrementalTickets), arg0, arg1)
+}
+
+// GetJobStatus mocks base method.
+func (m *Client) GetJobStatus(arg0 context.Context, arg1 string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *ClientMockRecorder) GetJobStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*Client)(nil).GetJobStatus), arg0, arg1)
+}
+
 // GetLocales mocks base method.
 func (m *Client) GetLocales(arg0 context.Context) ([]zendesk.Locale, error) {
 	m.ctrl.T.Helper()
@@ -745,6 +1247,52 @@ func (mr *ClientMockRecorder) GetMacro(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), arg0, arg1)
 }
 
+// GetMacroCategories mocks base method.
+func (m *Client) GetMacroCategories(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroCategories", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroCategories indicates an expected call of GetMacroCategories.
+func (mr *ClientMockRecorder) GetMacroCategories(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroCategories", reflect.TypeOf((*Client)(nil).GetMacroCategories), arg0)
+}
+
+// GetMacroDefinitions mocks base method.
+func (m *Client) GetMacroDefinitions(arg0 context.Context) (zendesk.MacroDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroDefinitions", arg0)
+	ret0, _ := ret[0].(zendesk.MacroDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroDefinitions indicates an expected call of GetMacroDefinitions.
+func (mr *ClientMockRecorder) GetMacroDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroDefinitions", reflect.TypeOf((*Client)(nil).GetMacroDefinitions), arg0)
+}
+
+// GetMacroSummaries mocks base method.
+func (m *Client) GetMacroSummaries(arg0 context.Context, arg1 *zendesk.MacroListOptions) ([]zendesk.MacroSummary, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroSummaries", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.MacroSummary)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacroSummaries indicates an expected call of GetMacroSummaries.
+func (mr *ClientMockRecorder) GetMacroSummaries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroSummaries", reflect.TypeOf((*Client)(nil).GetMacroSummaries), arg0, arg1)
+}
+
 // GetMacros mocks base method.
 func (m *Client) GetMacros(arg0 context.Context, arg1 *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -761,6 +1309,37 @@ func (mr *ClientMockRecorder) GetMacros(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), arg0, arg1)
 }
 
+// GetMacrosByUsage mocks base method.
+func (m *Client) GetMacrosByUsage(arg0 context.Context, arg1 string, arg2 *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosByUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacrosByUsage indicates an expected call of GetMacrosByUsage.
+func (mr *ClientMockRecorder) GetMacrosByUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosByUsage", reflect.TypeOf((*Client)(nil).GetMacrosByUsage), arg0, arg1, arg2)
+}
+
+// GetManyJobStatuses mocks base method.
+func (m *Client) GetManyJobStatuses(arg0 context.Context, arg1 []string) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyJobStatuses", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyJobStatuses indicates an expected call of GetManyJobStatuses.
+func (mr *ClientMockRecorder) GetManyJobStatuses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyJobStatuses", reflect.TypeOf((*Client)(nil).GetManyJobStatuses), arg0, arg1)
+}
+
 // GetManyUsers mocks base method.
 func (m *Client) GetManyUsers(arg0 context.Context, arg1 *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -838,6 +1417,22 @@ func (mr *ClientMockRecorder) GetOrganizationTags(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), arg0, arg1)
 }
 
+// GetOrganizationTickets mocks base method.
+func (m *Client) GetOrganizationTickets(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
+func (mr *ClientMockRecorder) GetOrganizationTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), arg0, arg1, arg2)
+}
+
 // GetOrganizations mocks base method.
 func (m *Client) GetOrganizations(arg0 context.Context, arg1 *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -854,6 +1449,52 @@ func (mr *ClientMockRecorder) GetOrganizations(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), arg0, arg1)
 }
 
+// GetRecentTickets mocks base method.
+func (m *Client) GetRecentTickets(arg0 context.Context, arg1 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentTickets", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecentTickets indicates an expected call of GetRecentTickets.
+func (mr *ClientMockRecorder) GetRecentTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentTickets", reflect.TypeOf((*Client)(nil).GetRecentTickets), arg0, arg1)
+}
+
+// GetRoutingAttributeValues mocks base method.
+func (m *Client) GetRoutingAttributeValues(arg0 context.Context, arg1 string) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutingAttributeValues", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutingAttributeValues indicates an expected call of GetRoutingAttributeValues.
+func (mr *ClientMockRecorder) GetRoutingAttributeValues(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributeValues", reflect.TypeOf((*Client)(nil).GetRoutingAttributeValues), arg0, arg1)
+}
+
+// GetRoutingAttributes mocks base method.
+func (m *Client) GetRoutingAttributes(arg0 context.Context) ([]zendesk.RoutingAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutingAttributes", arg0)
+	ret0, _ := ret[0].([]zendesk.RoutingAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutingAttributes indicates an expected call of GetRoutingAttributes.
+func (mr *ClientMockRecorder) GetRoutingAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributes", reflect.TypeOf((*Client)(nil).GetRoutingAttributes), arg0)
+}
+
 // GetSLAPolicies mocks base method.
 func (m *Client) GetSLAPolicies(arg0 context.Context, arg1 *zendesk.SLAPolicyListOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -885,6 +1526,82 @@ func (mr *ClientMockRecorder) GetSLAPolicy(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), arg0, arg1)
 }
 
+// GetSatisfactionReason mocks base method.
+func (m *Client) GetSatisfactionReason(arg0 context.Context, arg1 int64) (zendesk.SatisfactionReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSatisfactionReason", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.SatisfactionReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSatisfactionReason indicates an expected call of GetSatisfactionReason.
+func (mr *ClientMockRecorder) GetSatisfactionReason(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionReason", reflect.TypeOf((*Client)(nil).GetSatisfactionReason), arg0, arg1)
+}
+
+// GetSatisfactionReasons mocks base method.
+func (m *Client) GetSatisfactionReasons(arg0 context.Context) ([]zendesk.SatisfactionReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSatisfactionReasons", arg0)
+	ret0, _ := ret[0].([]zendesk.SatisfactionReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSatisfactionReasons indicates an expected call of GetSatisfactionReasons.
+func (mr *ClientMockRecorder) GetSatisfactionReasons(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSatisfactionReasons", reflect.TypeOf((*Client)(nil).GetSatisfactionReasons), arg0)
+}
+
+// GetSideConversationEvents mocks base method.
+func (m *Client) GetSideConversationEvents(arg0 context.Context, arg1 int64, arg2 string) ([]zendesk.SideConversationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSideConversationEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.SideConversationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSideConversationEvents indicates an expected call of GetSideConversationEvents.
+func (mr *ClientMockRecorder) GetSideConversationEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSideConversationEvents", reflect.TypeOf((*Client)(nil).GetSideConversationEvents), arg0, arg1, arg2)
+}
+
+// GetSideConversations mocks base method.
+func (m *Client) GetSideConversations(arg0 context.Context, arg1 int64, arg2 *zendesk.SideConversationListOptions) ([]zendesk.SideConversation, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSideConversations", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.SideConversation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSideConversations indicates an expected call of GetSideConversations.
+func (mr *ClientMockRecorder) GetSideConversations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSideConversations", reflect.TypeOf((*Client)(nil).GetSideConversations), arg0, arg1, arg2)
+}
+
+// GetSupportAddresses mocks base method.
+func (m *Client) GetSupportAddresses(arg0 context.Context) ([]zendesk.SupportAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportAddresses", arg0)
+	ret0, _ := ret[0].([]zendesk.SupportAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportAddresses indicates an expected call of GetSupportAddresses.
+func (mr *ClientMockRecorder) GetSupportAddresses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportAddresses", reflect.TypeOf((*Client)(nil).GetSupportAddresses), arg0)
+}
+
 // GetTarget mocks base method.
 func (m *Client) GetTarget(arg0 context.Context, arg1 int64) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -962,6 +1679,21 @@ func (mr *ClientMockRecorder) GetTicketAudits(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudits", reflect.TypeOf((*Client)(nil).GetTicketAudits), arg0, arg1, arg2)
 }
 
+// GetTicketByExternalID mocks base method.
+func (m *Client) GetTicketByExternalID(arg0 context.Context, arg1 string) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketByExternalID", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketByExternalID indicates an expected call of GetTicketByExternalID.
+func (mr *ClientMockRecorder) GetTicketByExternalID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByExternalID", reflect.TypeOf((*Client)(nil).GetTicketByExternalID), arg0, arg1)
+}
+
 // GetTicketField mocks base method.
 func (m *Client) GetTicketField(arg0 context.Context, arg1 int64) (zendesk.TicketField, error) {
 	m.ctrl.T.Helper()
@@ -1024,6 +1756,99 @@ func (mr *ClientMockRecorder) GetTicketForms(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), arg0, arg1)
 }
 
+// GetTicketIncidents mocks base method.
+func (m *Client) GetTicketIncidents(arg0 context.Context, arg1 int64, arg2 *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketIncidents indicates an expected call of GetTicketIncidents.
+func (mr *ClientMockRecorder) GetTicketIncidents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidents", reflect.TypeOf((*Client)(nil).GetTicketIncidents), arg0, arg1, arg2)
+}
+
+// GetTicketMetric mocks base method.
+func (m *Client) GetTicketMetric(arg0 context.Context, arg1 int64) (zendesk.TicketMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetric", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.TicketMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketMetric indicates an expected call of GetTicketMetric.
+func (mr *ClientMockRecorder) GetTicketMetric(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetric", reflect.TypeOf((*Client)(nil).GetTicketMetric), arg0, arg1)
+}
+
+// GetTicketMetricEvents mocks base method.
+func (m *Client) GetTicketMetricEvents(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketMetricEventListOptions) ([]zendesk.MetricEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetricEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.MetricEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketMetricEvents indicates an expected call of GetTicketMetricEvents.
+func (mr *ClientMockRecorder) GetTicketMetricEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetricEvents", reflect.TypeOf((*Client)(nil).GetTicketMetricEvents), arg0, arg1, arg2)
+}
+
+// GetTicketMetrics mocks base method.
+func (m *Client) GetTicketMetrics(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.TicketMetric, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketMetrics", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.TicketMetric)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketMetrics indicates an expected call of GetTicketMetrics.
+func (mr *ClientMockRecorder) GetTicketMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketMetrics", reflect.TypeOf((*Client)(nil).GetTicketMetrics), arg0, arg1)
+}
+
+// GetTicketProblems mocks base method.
+func (m *Client) GetTicketProblems(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketProblems", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketProblems indicates an expected call of GetTicketProblems.
+func (mr *ClientMockRecorder) GetTicketProblems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketProblems", reflect.TypeOf((*Client)(nil).GetTicketProblems), arg0, arg1)
+}
+
+// GetTicketSkills mocks base method.
+func (m *Client) GetTicketSkills(arg0 context.Context, arg1 int64) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketSkills", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketSkills indicates an expected call of GetTicketSkills.
+func (mr *ClientMockRecorder) GetTicketSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketSkills", reflect.TypeOf((*Client)(nil).GetTicketSkills), arg0, arg1)
+}
+
 // GetTicketTags mocks base method.
 func (m *Client) GetTicketTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -1085,6 +1910,52 @@ func (mr *ClientMockRecorder) GetTrigger(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), arg0, arg1)
 }
 
+// GetTriggerCategories mocks base method.
+func (m *Client) GetTriggerCategories(arg0 context.Context, arg1 *zendesk.TriggerCategoryListOptions) ([]zendesk.TriggerCategory, zendesk.CursorPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategories", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.TriggerCategory)
+	ret1, _ := ret[1].(zendesk.CursorPagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggerCategories indicates an expected call of GetTriggerCategories.
+func (mr *ClientMockRecorder) GetTriggerCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategories", reflect.TypeOf((*Client)(nil).GetTriggerCategories), arg0, arg1)
+}
+
+// GetTriggerCategory mocks base method.
+func (m *Client) GetTriggerCategory(arg0 context.Context, arg1 string) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategory", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerCategory indicates an expected call of GetTriggerCategory.
+func (mr *ClientMockRecorder) GetTriggerCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategory", reflect.TypeOf((*Client)(nil).GetTriggerCategory), arg0, arg1)
+}
+
+// GetTriggerDefinitions mocks base method.
+func (m *Client) GetTriggerDefinitions(arg0 context.Context) (zendesk.TriggerDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerDefinitions", arg0)
+	ret0, _ := ret[0].(zendesk.TriggerDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerDefinitions indicates an expected call of GetTriggerDefinitions.
+func (mr *ClientMockRecorder) GetTriggerDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerDefinitions", reflect.TypeOf((*Client)(nil).GetTriggerDefinitions), arg0)
+}
+
 // GetTriggers mocks base method.
 func (m *Client) GetTriggers(arg0 context.Context, arg1 *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1116,27 +1987,59 @@ func (mr *ClientMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), arg0, arg1)
 }
 
-// GetUserFields mocks base method.
-func (m *Client) GetUserFields(arg0 context.Context, arg1 *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// GetUserAssignedTickets mocks base method.
+func (m *Client) GetUserAssignedTickets(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFields", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserAssignedTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFields indicates an expected call of GetUserFields.
-func (mr *ClientMockRecorder) GetUserFields(arg0, arg1 interface{}) *gomock.Call {
+// GetUserAssignedTickets indicates an expected call of GetUserAssignedTickets.
+func (mr *ClientMockRecorder) GetUserAssignedTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAssignedTickets", reflect.TypeOf((*Client)(nil).GetUserAssignedTickets), arg0, arg1, arg2)
 }
 
-// GetUserRelated mocks base method.
-func (m *Client) GetUserRelated(arg0 context.Context, arg1 int64) (zendesk.UserRelated, error) {
+// GetUserCCDTickets mocks base method.
+func (m *Client) GetUserCCDTickets(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserRelated", arg0, arg1)
-	ret0, _ := ret[0].(zendesk.UserRelated)
+	ret := m.ctrl.Call(m, "GetUserCCDTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserCCDTickets indicates an expected call of GetUserCCDTickets.
+func (mr *ClientMockRecorder) GetUserCCDTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCCDTickets", reflect.TypeOf((*Client)(nil).GetUserCCDTickets), arg0, arg1, arg2)
+}
+
+// GetUserFields mocks base method.
+func (m *Client) GetUserFields(arg0 context.Context, arg1 *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFields", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFields indicates an expected call of GetUserFields.
+func (mr *ClientMockRecorder) GetUserFields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), arg0, arg1)
+}
+
+// GetUserRelated mocks base method.
+func (m *Client) GetUserRelated(arg0 context.Context, arg1 int64) (zendesk.UserRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRelated", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.UserRelated)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1147,6 +2050,22 @@ func (mr *ClientMockRecorder) GetUserRelated(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), arg0, arg1)
 }
 
+// GetUserRequestedTickets mocks base method.
+func (m *Client) GetUserRequestedTickets(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRequestedTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserRequestedTickets indicates an expected call of GetUserRequestedTickets.
+func (mr *ClientMockRecorder) GetUserRequestedTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRequestedTickets", reflect.TypeOf((*Client)(nil).GetUserRequestedTickets), arg0, arg1, arg2)
+}
+
 // GetUserTags mocks base method.
 func (m *Client) GetUserTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -1224,6 +2143,66 @@ func (mr *ClientMockRecorder) GetWebhook(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), arg0, arg1)
 }
 
+// GetWebhookInvocationAttempts mocks base method.
+func (m *Client) GetWebhookInvocationAttempts(arg0 context.Context, arg1, arg2 string) ([]zendesk.WebhookInvocationAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookInvocationAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocationAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookInvocationAttempts indicates an expected call of GetWebhookInvocationAttempts.
+func (mr *ClientMockRecorder) GetWebhookInvocationAttempts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookInvocationAttempts", reflect.TypeOf((*Client)(nil).GetWebhookInvocationAttempts), arg0, arg1, arg2)
+}
+
+// GetWebhookInvocations mocks base method.
+func (m *Client) GetWebhookInvocations(arg0 context.Context, arg1 string, arg2 *zendesk.WebhookInvocationListOptions) ([]zendesk.WebhookInvocation, zendesk.CursorPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookInvocations", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocation)
+	ret1, _ := ret[1].(zendesk.CursorPagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookInvocations indicates an expected call of GetWebhookInvocations.
+func (mr *ClientMockRecorder) GetWebhookInvocations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookInvocations", reflect.TypeOf((*Client)(nil).GetWebhookInvocations), arg0, arg1, arg2)
+}
+
+// IncrementalOrganizationTicketExport mocks base method.
+func (m *Client) IncrementalOrganizationTicketExport(arg0 context.Context, arg1 int64, arg2 time.Time) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementalOrganizationTicketExport", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementalOrganizationTicketExport indicates an expected call of IncrementalOrganizationTicketExport.
+func (mr *ClientMockRecorder) IncrementalOrganizationTicketExport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementalOrganizationTicketExport", reflect.TypeOf((*Client)(nil).IncrementalOrganizationTicketExport), arg0, arg1, arg2)
+}
+
+// IterateMacros mocks base method.
+func (m *Client) IterateMacros(arg0 *zendesk.MacroListOptions) *zendesk.MacroIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateMacros", arg0)
+	ret0, _ := ret[0].(*zendesk.MacroIterator)
+	return ret0
+}
+
+// IterateMacros indicates an expected call of IterateMacros.
+func (mr *ClientMockRecorder) IterateMacros(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateMacros", reflect.TypeOf((*Client)(nil).IterateMacros), arg0)
+}
+
 // ListTicketComments mocks base method.
 func (m *Client) ListTicketComments(arg0 context.Context, arg1 int64) ([]zendesk.TicketComment, error) {
 	m.ctrl.T.Helper()
@@ -1239,6 +2218,51 @@ func (mr *ClientMockRecorder) ListTicketComments(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketComments", reflect.TypeOf((*Client)(nil).ListTicketComments), arg0, arg1)
 }
 
+// MacroChangeSummary mocks base method.
+func (m *Client) MacroChangeSummary(arg0 context.Context, arg1, arg2 int64) ([]zendesk.FieldChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MacroChangeSummary", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.FieldChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MacroChangeSummary indicates an expected call of MacroChangeSummary.
+func (mr *ClientMockRecorder) MacroChangeSummary(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MacroChangeSummary", reflect.TypeOf((*Client)(nil).MacroChangeSummary), arg0, arg1, arg2)
+}
+
+// MacrosChangedSince mocks base method.
+func (m *Client) MacrosChangedSince(arg0 context.Context, arg1 time.Time) ([]zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MacrosChangedSince", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MacrosChangedSince indicates an expected call of MacrosChangedSince.
+func (mr *ClientMockRecorder) MacrosChangedSince(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MacrosChangedSince", reflect.TypeOf((*Client)(nil).MacrosChangedSince), arg0, arg1)
+}
+
+// PermanentlyDeleteUser mocks base method.
+func (m *Client) PermanentlyDeleteUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentlyDeleteUser indicates an expected call of PermanentlyDeleteUser.
+func (mr *ClientMockRecorder) PermanentlyDeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteUser", reflect.TypeOf((*Client)(nil).PermanentlyDeleteUser), arg0, arg1)
+}
+
 // Post mocks base method.
 func (m *Client) Post(arg0 context.Context, arg1 string, arg2 interface{}) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1254,6 +2278,21 @@ func (mr *ClientMockRecorder) Post(arg0, arg1, arg2 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), arg0, arg1, arg2)
 }
 
+// PreviewRedactTicketPatterns mocks base method.
+func (m *Client) PreviewRedactTicketPatterns(arg0 context.Context, arg1 int64, arg2 []*regexp.Regexp) ([]zendesk.RedactedComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewRedactTicketPatterns", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.RedactedComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewRedactTicketPatterns indicates an expected call of PreviewRedactTicketPatterns.
+func (mr *ClientMockRecorder) PreviewRedactTicketPatterns(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewRedactTicketPatterns", reflect.TypeOf((*Client)(nil).PreviewRedactTicketPatterns), arg0, arg1, arg2)
+}
+
 // Put mocks base method.
 func (m *Client) Put(arg0 context.Context, arg1 string, arg2 interface{}) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1269,6 +2308,79 @@ func (mr *ClientMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), arg0, arg1, arg2)
 }
 
+// RedactTicketComment mocks base method.
+func (m *Client) RedactTicketComment(arg0 context.Context, arg1, arg2 int64, arg3 string) (zendesk.TicketComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactTicketComment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.TicketComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactTicketComment indicates an expected call of RedactTicketComment.
+func (mr *ClientMockRecorder) RedactTicketComment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactTicketComment", reflect.TypeOf((*Client)(nil).RedactTicketComment), arg0, arg1, arg2, arg3)
+}
+
+// RedactTicketPatterns mocks base method.
+func (m *Client) RedactTicketPatterns(arg0 context.Context, arg1 int64, arg2 []*regexp.Regexp) ([]zendesk.RedactedComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactTicketPatterns", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.RedactedComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactTicketPatterns indicates an expected call of RedactTicketPatterns.
+func (mr *ClientMockRecorder) RedactTicketPatterns(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactTicketPatterns", reflect.TypeOf((*Client)(nil).RedactTicketPatterns), arg0, arg1, arg2)
+}
+
+// ReorderOrganizationFields mocks base method.
+func (m *Client) ReorderOrganizationFields(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderOrganizationFields", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderOrganizationFields indicates an expected call of ReorderOrganizationFields.
+func (mr *ClientMockRecorder) ReorderOrganizationFields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderOrganizationFields", reflect.TypeOf((*Client)(nil).ReorderOrganizationFields), arg0, arg1)
+}
+
+// ReorderUserFields mocks base method.
+func (m *Client) ReorderUserFields(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderUserFields", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderUserFields indicates an expected call of ReorderUserFields.
+func (mr *ClientMockRecorder) ReorderUserFields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserFields", reflect.TypeOf((*Client)(nil).ReorderUserFields), arg0, arg1)
+}
+
+// ReplySideConversation mocks base method.
+func (m *Client) ReplySideConversation(arg0 context.Context, arg1 int64, arg2 string, arg3 zendesk.Message) (zendesk.SideConversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplySideConversation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.SideConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplySideConversation indicates an expected call of ReplySideConversation.
+func (mr *ClientMockRecorder) ReplySideConversation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplySideConversation", reflect.TypeOf((*Client)(nil).ReplySideConversation), arg0, arg1, arg2, arg3)
+}
+
 // Search mocks base method.
 func (m *Client) Search(arg0 context.Context, arg1 *zendesk.SearchOptions) (zendesk.SearchResults, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1300,6 +2412,22 @@ func (mr *ClientMockRecorder) SearchCount(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCount", reflect.TypeOf((*Client)(nil).SearchCount), arg0, arg1)
 }
 
+// SearchOrganizationTickets mocks base method.
+func (m *Client) SearchOrganizationTickets(arg0 context.Context, arg1 int64, arg2 string, arg3 *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchOrganizationTickets", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchOrganizationTickets indicates an expected call of SearchOrganizationTickets.
+func (mr *ClientMockRecorder) SearchOrganizationTickets(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrganizationTickets", reflect.TypeOf((*Client)(nil).SearchOrganizationTickets), arg0, arg1, arg2, arg3)
+}
+
 // SearchUsers mocks base method.
 func (m *Client) SearchUsers(arg0 context.Context, arg1 *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +2444,65 @@ func (mr *ClientMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), arg0, arg1)
 }
 
+// SetCustomFieldByTitle mocks base method.
+func (m *Client) SetCustomFieldByTitle(arg0 context.Context, arg1 *zendesk.Ticket, arg2 string, arg3 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomFieldByTitle", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCustomFieldByTitle indicates an expected call of SetCustomFieldByTitle.
+func (mr *ClientMockRecorder) SetCustomFieldByTitle(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomFieldByTitle", reflect.TypeOf((*Client)(nil).SetCustomFieldByTitle), arg0, arg1, arg2, arg3)
+}
+
+// SetDefaultOrganizationMembership mocks base method.
+func (m *Client) SetDefaultOrganizationMembership(arg0 context.Context, arg1, arg2 int64) ([]zendesk.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultOrganizationMembership", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultOrganizationMembership indicates an expected call of SetDefaultOrganizationMembership.
+func (mr *ClientMockRecorder) SetDefaultOrganizationMembership(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganizationMembership", reflect.TypeOf((*Client)(nil).SetDefaultOrganizationMembership), arg0, arg1, arg2)
+}
+
+// SetTicketPriority mocks base method.
+func (m *Client) SetTicketPriority(arg0 context.Context, arg1 int64, arg2 zendesk.TicketPriority) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTicketPriority", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTicketPriority indicates an expected call of SetTicketPriority.
+func (mr *ClientMockRecorder) SetTicketPriority(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTicketPriority", reflect.TypeOf((*Client)(nil).SetTicketPriority), arg0, arg1, arg2)
+}
+
+// SetTicketStatus mocks base method.
+func (m *Client) SetTicketStatus(arg0 context.Context, arg1 int64, arg2 zendesk.TicketStatus) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTicketStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTicketStatus indicates an expected call of SetTicketStatus.
+func (mr *ClientMockRecorder) SetTicketStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTicketStatus", reflect.TypeOf((*Client)(nil).SetTicketStatus), arg0, arg1, arg2)
+}
+
 // ShowChangesToTicket mocks base method.
 func (m *Client) ShowChangesToTicket(arg0 context.Context, arg1 int64) (zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -1346,6 +2533,137 @@ func (mr *ClientMockRecorder) ShowTicketAfterChanges(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTicketAfterChanges", reflect.TypeOf((*Client)(nil).ShowTicketAfterChanges), arg0, arg1, arg2)
 }
 
+// SuspendUser mocks base method.
+func (m *Client) SuspendUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *ClientMockRecorder) SuspendUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*Client)(nil).SuspendUser), arg0, arg1)
+}
+
+// SyncAutomationPositions mocks base method.
+func (m *Client) SyncAutomationPositions(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncAutomationPositions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncAutomationPositions indicates an expected call of SyncAutomationPositions.
+func (mr *ClientMockRecorder) SyncAutomationPositions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAutomationPositions", reflect.TypeOf((*Client)(nil).SyncAutomationPositions), arg0, arg1)
+}
+
+// SyncMacroPositions mocks base method.
+func (m *Client) SyncMacroPositions(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncMacroPositions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncMacroPositions indicates an expected call of SyncMacroPositions.
+func (mr *ClientMockRecorder) SyncMacroPositions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMacroPositions", reflect.TypeOf((*Client)(nil).SyncMacroPositions), arg0, arg1)
+}
+
+// SyncTriggerPositions mocks base method.
+func (m *Client) SyncTriggerPositions(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncTriggerPositions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncTriggerPositions indicates an expected call of SyncTriggerPositions.
+func (mr *ClientMockRecorder) SyncTriggerPositions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTriggerPositions", reflect.TypeOf((*Client)(nil).SyncTriggerPositions), arg0, arg1)
+}
+
+// SyncViewPositions mocks base method.
+func (m *Client) SyncViewPositions(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncViewPositions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncViewPositions indicates an expected call of SyncViewPositions.
+func (mr *ClientMockRecorder) SyncViewPositions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncViewPositions", reflect.TypeOf((*Client)(nil).SyncViewPositions), arg0, arg1)
+}
+
+// TagTicketsMatching mocks base method.
+func (m *Client) TagTicketsMatching(arg0 context.Context, arg1 string, arg2 []string, arg3 bool) ([]zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagTicketsMatching", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagTicketsMatching indicates an expected call of TagTicketsMatching.
+func (mr *ClientMockRecorder) TagTicketsMatching(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagTicketsMatching", reflect.TypeOf((*Client)(nil).TagTicketsMatching), arg0, arg1, arg2, arg3)
+}
+
+// UnsuspendUser mocks base method.
+func (m *Client) UnsuspendUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsuspendUser indicates an expected call of UnsuspendUser.
+func (mr *ClientMockRecorder) UnsuspendUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUser", reflect.TypeOf((*Client)(nil).UnsuspendUser), arg0, arg1)
+}
+
+// UpdateAccountSettings mocks base method.
+func (m *Client) UpdateAccountSettings(arg0 context.Context, arg1 zendesk.AccountSettings) (zendesk.AccountSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountSettings", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountSettings indicates an expected call of UpdateAccountSettings.
+func (mr *ClientMockRecorder) UpdateAccountSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountSettings", reflect.TypeOf((*Client)(nil).UpdateAccountSettings), arg0, arg1)
+}
+
+// UpdateAppInstallation mocks base method.
+func (m *Client) UpdateAppInstallation(arg0 context.Context, arg1 int64, arg2 zendesk.AppInstallation) (zendesk.AppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAppInstallation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.AppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAppInstallation indicates an expected call of UpdateAppInstallation.
+func (mr *ClientMockRecorder) UpdateAppInstallation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppInstallation", reflect.TypeOf((*Client)(nil).UpdateAppInstallation), arg0, arg1, arg2)
+}
+
 // UpdateAutomation mocks base method.
 func (m *Client) UpdateAutomation(arg0 context.Context, arg1 int64, arg2 zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -1421,6 +2739,21 @@ func (mr *ClientMockRecorder) UpdateMacro(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), arg0, arg1, arg2)
 }
 
+// UpdateManyTickets mocks base method.
+func (m *Client) UpdateManyTickets(arg0 context.Context, arg1 []int64, arg2 zendesk.Ticket) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyTickets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyTickets indicates an expected call of UpdateManyTickets.
+func (mr *ClientMockRecorder) UpdateManyTickets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyTickets", reflect.TypeOf((*Client)(nil).UpdateManyTickets), arg0, arg1, arg2)
+}
+
 // UpdateOrganization mocks base method.
 func (m *Client) UpdateOrganization(arg0 context.Context, arg1 int64, arg2 zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
@@ -1526,6 +2859,21 @@ func (mr *ClientMockRecorder) UpdateTrigger(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrigger", reflect.TypeOf((*Client)(nil).UpdateTrigger), arg0, arg1, arg2)
 }
 
+// UpdateTriggerCategory mocks base method.
+func (m *Client) UpdateTriggerCategory(arg0 context.Context, arg1 string, arg2 zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriggerCategory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriggerCategory indicates an expected call of UpdateTriggerCategory.
+func (mr *ClientMockRecorder) UpdateTriggerCategory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerCategory", reflect.TypeOf((*Client)(nil).UpdateTriggerCategory), arg0, arg1, arg2)
+}
+
 // UpdateUser mocks base method.
 func (m *Client) UpdateUser(arg0 context.Context, arg1 int64, arg2 zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -1568,3 +2916,48 @@ func (mr *ClientMockRecorder) UploadAttachment(arg0, arg1, arg2 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*Client)(nil).UploadAttachment), arg0, arg1, arg2)
 }
+
+// UploadSideConversationAttachment mocks base method.
+func (m *Client) UploadSideConversationAttachment(arg0 context.Context, arg1 string, arg2 io.Reader) (zendesk.SideConversationAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSideConversationAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.SideConversationAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSideConversationAttachment indicates an expected call of UploadSideConversationAttachment.
+func (mr *ClientMockRecorder) UploadSideConversationAttachment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSideConversationAttachment", reflect.TypeOf((*Client)(nil).UploadSideConversationAttachment), arg0, arg1, arg2)
+}
+
+// UploadSideConversationAttachmentWithProgress mocks base method.
+func (m *Client) UploadSideConversationAttachmentWithProgress(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 int64, arg4 func(int64)) (zendesk.SideConversationAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSideConversationAttachmentWithProgress", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(zendesk.SideConversationAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSideConversationAttachmentWithProgress indicates an expected call of UploadSideConversationAttachmentWithProgress.
+func (mr *ClientMockRecorder) UploadSideConversationAttachmentWithProgress(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSideConversationAttachmentWithProgress", reflect.TypeOf((*Client)(nil).UploadSideConversationAttachmentWithProgress), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ValidateRecipient mocks base method.
+func (m *Client) ValidateRecipient(arg0 context.Context, arg1 string) (zendesk.SupportAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRecipient", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.SupportAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateRecipient indicates an expected call of ValidateRecipient.
+func (mr *ClientMockRecorder) ValidateRecipient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRecipient", reflect.TypeOf((*Client)(nil).ValidateRecipient), arg0, arg1)
+}
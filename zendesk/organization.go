@@ -40,6 +40,7 @@ type OrganizationAPI interface {
 	GetOrganization(ctx context.Context, orgID int64) (Organization, error)
 	UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error)
 	DeleteOrganization(ctx context.Context, orgID int64) error
+	AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error)
 }
 
 // GetOrganizations fetch organization list
@@ -57,17 +58,17 @@ func (z *Client) GetOrganizations(ctx context.Context, opts *OrganizationListOpt
 
 	u, err := addOptions("/organizations.json", opts)
 	if err != nil {
-		return []Organization{}, Page{}, err
+		return []Organization{}, Page{}, fmt.Errorf("get organizations: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []Organization{}, Page{}, err
+		return []Organization{}, Page{}, fmt.Errorf("get organizations: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []Organization{}, Page{}, err
+		return []Organization{}, Page{}, fmt.Errorf("get organizations: %w", err)
 	}
 
 	return data.Organizations, data.Page, nil
@@ -84,12 +85,12 @@ func (z *Client) CreateOrganization(ctx context.Context, org Organization) (Orga
 
 	body, err := z.post(ctx, "/organizations.json", data)
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("create organization: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("create organization: %w", err)
 	}
 
 	return result.Organization, nil
@@ -105,12 +106,12 @@ func (z *Client) GetOrganization(ctx context.Context, orgID int64) (Organization
 	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d.json", orgID))
 
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("get organization %d: %w", orgID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("get organization %d: %w", orgID, err)
 	}
 
 	return result.Organization, err
@@ -128,12 +129,12 @@ func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, org Organi
 	body, err := z.put(ctx, fmt.Sprintf("/organizations/%d.json", orgID), data)
 
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("update organization %d: %w", orgID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Organization{}, err
+		return Organization{}, fmt.Errorf("update organization %d: %w", orgID, err)
 	}
 
 	return result.Organization, err
@@ -145,8 +146,38 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/organizations/%d.json", orgID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete organization %d: %w", orgID, err)
 	}
 
 	return nil
 }
+
+// AutocompleteOrganizations gets organizations whose name starts with name.
+// Zendesk requires name to be at least 2 characters.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#autocomplete-organizations
+func (z *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+	}
+
+	opts := struct {
+		Name string `url:"name"`
+	}{Name: name}
+
+	u, err := addOptions("/organizations/autocomplete.json", opts)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete organizations: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete organizations: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete organizations: %w", err)
+	}
+	return data.Organizations, nil
+}
@@ -0,0 +1,21 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrganizationFieldAPI an interface containing all organization field related methods
+type OrganizationFieldAPI interface {
+	ReorderOrganizationFields(ctx context.Context, orderedIDs []int64) error
+}
+
+// ReorderOrganizationFields sets the order of all organization fields in one request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#reorder-organization-field
+func (z *Client) ReorderOrganizationFields(ctx context.Context, orderedIDs []int64) error {
+	if err := z.reorderFields(ctx, "organization_field", orderedIDs); err != nil {
+		return fmt.Errorf("reorder organization fields: %w", err)
+	}
+	return nil
+}
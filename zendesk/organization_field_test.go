@@ -0,0 +1,31 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReorderOrganizationFields(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/organization_fields/reorder.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.ReorderOrganizationFields(ctx, []int64{7, 5}); err != nil {
+		t.Fatalf("Failed to reorder organization fields: %s", err)
+	}
+
+	expected := `{"organization_field_ids":[7,5]}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -31,6 +32,7 @@ type (
 	// OrganizationMembershipAPI is an interface containing organization membership related methods
 	OrganizationMembershipAPI interface {
 		GetOrganizationMemberships(context.Context, *OrganizationMembershipListOptions) ([]OrganizationMembership, Page, error)
+		SetDefaultOrganizationMembership(ctx context.Context, userID, membershipID int64) ([]OrganizationMembership, error)
 	}
 )
 
@@ -49,17 +51,37 @@ func (z *Client) GetOrganizationMemberships(ctx context.Context, opts *Organizat
 
 	u, err := addOptions("/organization_memberships.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get organization memberships: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get organization memberships: %w", err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get organization memberships: %w", err)
 	}
 
 	return result.OrganizationMemberships, result.Page, nil
 }
+
+// SetDefaultOrganizationMembership makes the membership the default organization of the user.
+// It returns all memberships of the user.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#set-membership-as-default
+func (z *Client) SetDefaultOrganizationMembership(ctx context.Context, userID, membershipID int64) ([]OrganizationMembership, error) {
+	var result struct {
+		OrganizationMemberships []OrganizationMembership `json:"organization_memberships"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/organization_memberships/%d/make_default.json", userID, membershipID), struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("set default organization membership %d of user %d: %w", membershipID, userID, err)
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("set default organization membership %d of user %d: %w", membershipID, userID, err)
+	}
+
+	return result.OrganizationMemberships, nil
+}
@@ -0,0 +1,31 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetDefaultOrganizationMembership(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write(readFixture(filepath.Join(http.MethodPut, "organization_membership_default.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	memberships, err := client.SetDefaultOrganizationMembership(ctx, 29, 5)
+	if err != nil {
+		t.Fatalf("Failed to set default organization membership: %s", err)
+	}
+
+	if path != "/users/29/organization_memberships/5/make_default.json" {
+		t.Fatalf("Unexpected request path %s", path)
+	}
+
+	if len(memberships) != 2 || memberships[0].Default || !memberships[1].Default {
+		t.Fatalf("Unexpected memberships %v", memberships)
+	}
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +77,26 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestAutocompleteOrganizations(t *testing.T) {
+	var name string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name = r.URL.Query().Get("name")
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.AutocompleteOrganizations(ctx, "Re")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete organizations: %s", err)
+	}
+
+	if name != "Re" {
+		t.Fatalf("expected name Re, but got %q", name)
+	}
+
+	if len(orgs) == 0 {
+		t.Fatal("expected organizations, but got none")
+	}
+}
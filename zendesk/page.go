@@ -1,5 +1,11 @@
 package zendesk
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 // Page is base struct for resource pagination
 type Page struct {
 	PreviousPage *string `json:"previous_page"`
@@ -16,6 +22,9 @@ type PageOptions struct {
 	Page    int `url:"page,omitempty"`
 }
 
+// maxPerPage is the largest per_page Zendesk accepts
+const maxPerPage = 100
+
 // HasPrev checks if the Page has previous page
 func (p Page) HasPrev() bool {
 	return (p.PreviousPage != nil)
@@ -25,3 +34,33 @@ func (p Page) HasPrev() bool {
 func (p Page) HasNext() bool {
 	return (p.NextPage != nil)
 }
+
+// NextPageNumber returns the number of the next page, parsed from NextPage.
+// It can be set to PageOptions.Page to fetch the next page.
+func (p Page) NextPageNumber() (int, error) {
+	return pageNumber(p.NextPage)
+}
+
+// PrevPageNumber returns the number of the previous page, parsed from PreviousPage
+func (p Page) PrevPageNumber() (int, error) {
+	return pageNumber(p.PreviousPage)
+}
+
+// pageNumber parses the page query parameter of a page URL
+func pageNumber(pageURL *string) (int, error) {
+	if pageURL == nil {
+		return 0, fmt.Errorf("no such page")
+	}
+
+	u, err := url.Parse(*pageURL)
+	if err != nil {
+		return 0, err
+	}
+
+	page := u.Query().Get("page")
+	if page == "" {
+		return 0, fmt.Errorf("page URL %s has no page parameter", *pageURL)
+	}
+
+	return strconv.Atoi(page)
+}
@@ -23,3 +23,36 @@ func TestHasPrev(t *testing.T) {
 		t.Fatalf("expect false, but got true")
 	}
 }
+
+func TestNextPageNumber(t *testing.T) {
+	pageURL := "https://example.zendesk.com/api/v2/macros.json?page=3&per_page=10"
+
+	page, err := (Page{NextPage: &pageURL}).NextPageNumber()
+	if err != nil {
+		t.Fatalf("Failed to get next page number: %s", err)
+	}
+	if page != 3 {
+		t.Fatalf("expect 3, but got %d", page)
+	}
+
+	if _, err := (Page{}).NextPageNumber(); err == nil {
+		t.Fatalf("expect error without next page")
+	}
+
+	noParam := "https://example.zendesk.com/api/v2/macros.json"
+	if _, err := (Page{NextPage: &noParam}).NextPageNumber(); err == nil {
+		t.Fatalf("expect error without page parameter")
+	}
+}
+
+func TestPrevPageNumber(t *testing.T) {
+	pageURL := "https://example.zendesk.com/api/v2/macros.json?page=1"
+
+	page, err := (Page{PreviousPage: &pageURL}).PrevPageNumber()
+	if err != nil {
+		t.Fatalf("Failed to get previous page number: %s", err)
+	}
+	if page != 1 {
+		t.Fatalf("expect 1, but got %d", page)
+	}
+}
@@ -0,0 +1,30 @@
+package zendesk
+
+import "context"
+
+// rulePosition is an item of the update_many payload which reorders business rules
+type rulePosition struct {
+	ID       int64 `json:"id"`
+	Position int   `json:"position"`
+}
+
+// syncPositions sets the positions of all rules of a kind in one update_many request.
+// The rule at orderedIDs[0] gets position 1 and so on.
+func (z *Client) syncPositions(ctx context.Context, kind string, orderedIDs []int64) error {
+	positions := make([]rulePosition, len(orderedIDs))
+	for i, id := range orderedIDs {
+		positions[i] = rulePosition{ID: id, Position: i + 1}
+	}
+
+	data := map[string][]rulePosition{kind: positions}
+	_, err := z.put(ctx, "/"+kind+"/update_many.json", data)
+	return err
+}
+
+// reorderFields sets the order of all user or organization fields in one request.
+// kind is "user_field" or "organization_field". The field at orderedIDs[0] comes first and so on.
+func (z *Client) reorderFields(ctx context.Context, kind string, orderedIDs []int64) error {
+	data := map[string][]int64{kind + "_ids": orderedIDs}
+	_, err := z.put(ctx, "/"+kind+"s/reorder.json", data)
+	return err
+}
@@ -0,0 +1,50 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestSyncPositions(t *testing.T) {
+	var path string
+	var payload map[string][]rulePosition
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.SyncMacroPositions(ctx, []int64{30, 10, 20}); err != nil {
+		t.Fatalf("Failed to sync macro positions: %s", err)
+	}
+
+	if path != "/macros/update_many.json" {
+		t.Fatalf("Unexpected request path %s", path)
+	}
+
+	expected := []rulePosition{{ID: 30, Position: 1}, {ID: 10, Position: 2}, {ID: 20, Position: 3}}
+	if !reflect.DeepEqual(payload["macros"], expected) {
+		t.Fatalf("expected positions %v, but got %v", expected, payload["macros"])
+	}
+
+	for name, sync := range map[string]func() error{
+		"/triggers/update_many.json":    func() error { return client.SyncTriggerPositions(ctx, []int64{1}) },
+		"/automations/update_many.json": func() error { return client.SyncAutomationPositions(ctx, []int64{1}) },
+		"/views/update_many.json":       func() error { return client.SyncViewPositions(ctx, []int64{1}) },
+	} {
+		if err := sync(); err != nil {
+			t.Fatalf("Failed to sync positions with %s: %s", name, err)
+		}
+		if path != name {
+			t.Fatalf("expected request path %s, but got %s", name, path)
+		}
+	}
+}
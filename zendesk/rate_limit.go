@@ -0,0 +1,71 @@
+package zendesk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by all requests of a Client.
+// Tokens are refilled continuously so that requests are spread evenly
+// over a minute instead of being sent in bursts.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Minute / time.Duration(requestsPerMinute),
+		tokens:   1,
+		last:     time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+	if l.tokens > 1 {
+		l.tokens = 1
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel gives back a token which was reserved but not used
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// wait blocks until a token is available or ctx is done.
+// It returns how long it waited, or would have waited when ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	d := l.reserve()
+	if d == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return d, nil
+	case <-ctx.Done():
+		l.cancel()
+		return d, ctx.Err()
+	}
+}
@@ -0,0 +1,65 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterSpreadsRequests(t *testing.T) {
+	limiter := newRateLimiter(600) // one request per 100ms
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := limiter.wait(ctx); err != nil {
+			t.Fatalf("Failed to wait for limiter: %s", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected requests to be spread over at least 150ms, but took %s", elapsed)
+	}
+}
+
+func TestRateLimiterCanceledContext(t *testing.T) {
+	limiter := newRateLimiter(1)
+	if _, err := limiter.wait(ctx); err != nil {
+		t.Fatalf("First request should not wait: %s", err)
+	}
+
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := limiter.wait(canceled)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %s, but got %v", context.DeadlineExceeded, err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("wait did not return promptly after cancel: %s", elapsed)
+	}
+}
+
+func TestSetRateLimit(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRateLimit(1)
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.get(canceled, "/groups.json"); err != context.DeadlineExceeded {
+		t.Fatalf("expected second request to be rate limited, but got %v", err)
+	}
+
+	client.SetRateLimit(0)
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request without limit: %s", err)
+	}
+}
@@ -0,0 +1,47 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+)
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx which makes the client send key
+// in the Idempotency-Key header of create requests, such as CreateTicket,
+// CreateMacro and CreateSideConversation. When a create is retried with the
+// same key, e.g. after a network error, Zendesk returns the result of the
+// first request instead of creating a duplicate.
+//
+// ref: https://developer.zendesk.com/api-reference/introduction/idempotency/
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+type actAsKey struct{}
+
+// WithActAs returns a copy of ctx which makes the client send requests on behalf
+// of the user with email in the X-On-Behalf-Of header, so that tickets, comments
+// and side conversations created with ctx appear to come from that user.
+// Zendesk only honors it for OAuth tokens with the impersonate scope.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/working-with-oauth/making-api-requests-on-behalf-of-end-users/
+func WithActAs(ctx context.Context, email string) context.Context {
+	return context.WithValue(ctx, actAsKey{}, email)
+}
+
+// actingAs reports whether requests with ctx are sent on behalf of another user
+func actingAs(ctx context.Context) bool {
+	email, ok := ctx.Value(actAsKey{}).(string)
+	return ok && email != ""
+}
+
+// setRequestOptions sets headers for the per-call options attached to the request's context
+func setRequestOptions(req *http.Request) {
+	if key, ok := req.Context().Value(idempotencyKey{}).(string); ok && key != "" && req.Method == http.MethodPost {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if email, ok := req.Context().Value(actAsKey{}).(string); ok && email != "" {
+		req.Header.Set("X-On-Behalf-Of", email)
+	}
+}
@@ -0,0 +1,84 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	keyCtx := WithIdempotencyKey(ctx, "message-42")
+	if _, err := client.CreateTicket(keyCtx, Ticket{Subject: "retried"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	if _, err := client.CreateSideConversation(keyCtx, 2, Message{Subject: "retried"}); err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "no key"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	expected := []string{"message-42", "message-42", ""}
+	for i, key := range expected {
+		if keys[i] != key {
+			t.Fatalf("expected Idempotency-Key %q for request %d, but got %q", key, i, keys[i])
+		}
+	}
+}
+
+func TestWithIdempotencyKeyOnlyForCreate(t *testing.T) {
+	var key string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get("Idempotency-Key")
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetTicket(WithIdempotencyKey(ctx, "message-42"), 2); err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if key != "" {
+		t.Fatalf("Idempotency-Key should not be sent with GET requests: %q", key)
+	}
+}
+
+func TestWithActAs(t *testing.T) {
+	var onBehalfOf []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		onBehalfOf = append(onBehalfOf, r.Header.Get("X-On-Behalf-Of"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agentCtx := WithActAs(ctx, "agent@example.com")
+	if _, err := client.CreateTicket(agentCtx, Ticket{Subject: "from agent"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	if _, err := client.CreateSideConversation(agentCtx, 2, Message{Subject: "from agent"}); err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "from integration"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	expected := []string{"agent@example.com", "agent@example.com", ""}
+	for i, email := range expected {
+		if onBehalfOf[i] != email {
+			t.Fatalf("expected X-On-Behalf-Of %q for request %d, but got %q", email, i, onBehalfOf[i])
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Response is metadata of the HTTP response which Zendesk returned for a call.
+// It is filled in when the call's context is created with WithResponse.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+
+	// Warnings are the "warnings" which Zendesk may return with a successful
+	// create or update, e.g. for deprecated macro actions. They are kept as
+	// returned because their shape differs between endpoints.
+	Warnings []json.RawMessage
+
+	// Location is the URL of the resource created by a create call, such as
+	// CreateTicket, CreateMacro or CreateSideConversation, taken from the
+	// Location header. It's empty when Zendesk doesn't return the header.
+	Location string
+
+	// Duration is how long the request took, including the retries and
+	// the waits for the rate limiter and before each retry.
+	Duration time.Duration
+
+	// Retries is how many times the request was retried as configured by SetRetry
+	Retries int
+}
+
+type responseKey struct{}
+
+// WithResponse returns a copy of ctx which makes the client save metadata of
+// the response into resp. It lets callers tell e.g. 200 OK from 201 Created,
+// or see warnings returned for a create or update:
+//
+//	var resp zendesk.Response
+//	macro, err := client.CreateMacro(zendesk.WithResponse(ctx, &resp), macro)
+//	if err == nil && resp.StatusCode == http.StatusCreated {
+//		// a new macro was created
+//	}
+//
+// Duration and Retries are saved even when no response was received.
+// When a method sends several requests, resp holds the last response.
+func WithResponse(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, resp)
+}
+
+// saveResponse copies metadata of resp and of the call into the Response attached to ctx, if any
+func saveResponse(ctx context.Context, resp *http.Response, retries int, duration time.Duration) {
+	out, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || out == nil {
+		return
+	}
+
+	out.Duration = duration
+	out.Retries = retries
+	if resp == nil {
+		return
+	}
+
+	out.StatusCode = resp.StatusCode
+	out.Header = resp.Header
+	out.Location = resp.Header.Get("Location")
+}
+
+// saveWarnings copies warnings in the response body into the Response attached to ctx, if any
+func saveWarnings(ctx context.Context, body []byte) {
+	out, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || out == nil {
+		return
+	}
+
+	var data struct {
+		Warnings []json.RawMessage `json:"warnings"`
+	}
+	// the body is decoded again by the caller, which reports invalid JSON
+	_ = json.Unmarshal(body, &data)
+	out.Warnings = data.Warnings
+}
@@ -0,0 +1,111 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWithResponse(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "groups.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var resp Response
+	_, err := client.CreateGroup(WithResponse(ctx, &resp), Group{Name: "support"})
+	if err != nil {
+		t.Fatalf("Failed to create group: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status code %d, but got %d", http.StatusCreated, resp.StatusCode)
+	}
+
+	if resp.Header.Get("Content-Type") == "" {
+		t.Fatal("expected response headers to be saved")
+	}
+}
+
+func TestWithResponseLocation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "https://example.zendesk.com/api/v2/tickets/35436.json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var resp Response
+	_, err := client.CreateTicket(WithResponse(ctx, &resp), Ticket{Comment: &TicketComment{Body: "Help"}})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	expected := "https://example.zendesk.com/api/v2/tickets/35436.json"
+	if resp.Location != expected {
+		t.Fatalf("expected location %s, but got %s", expected, resp.Location)
+	}
+}
+
+func TestWithResponseWarnings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{
+			"macro": {"id": 1, "title": "Close", "actions": []},
+			"warnings": [{"code": "deprecated_action", "message": "priority is deprecated"}]
+		}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var resp Response
+	_, err := client.CreateMacro(WithResponse(ctx, &resp), Macro{Title: "Close"})
+	if err != nil {
+		t.Fatalf("Failed to create macro: %s", err)
+	}
+
+	if len(resp.Warnings) != 1 {
+		t.Fatalf("expected 1 warning, but got %d", len(resp.Warnings))
+	}
+
+	expected := `{"code": "deprecated_action", "message": "priority is deprecated"}`
+	if string(resp.Warnings[0]) != expected {
+		t.Fatalf("expected warning %s, but got %s", expected, resp.Warnings[0])
+	}
+}
+
+func TestWithResponseStats(t *testing.T) {
+	defer func(d time.Duration) { retryBaseDelay = d }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var calls int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRetry(3, DefaultShouldRetry)
+
+	var resp Response
+	if _, _, err := client.GetGroups(WithResponse(ctx, &resp), nil); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+
+	if resp.Retries != 2 {
+		t.Fatalf("expected 2 retries, but got %d", resp.Retries)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if resp.Duration < 3*time.Millisecond {
+		t.Fatalf("expected duration to include the retry delays, but got %s", resp.Duration)
+	}
+}
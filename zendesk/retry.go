@@ -0,0 +1,97 @@
+package zendesk
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryBaseDelay is how long the first retry waits when the response has no Retry-After.
+// The delay doubles with every retry.
+var retryBaseDelay = 500 * time.Millisecond
+
+// SetRetry retries failed requests up to maxRetries times when shouldRetry returns true
+// for the response or error. When shouldRetry is nil, DefaultShouldRetry is used.
+// Retries wait for the Retry-After of the response, or back off exponentially,
+// and stop when the context is done.
+// A value of maxRetries less than or equal to 0 disables retries.
+func (z *Client) SetRetry(maxRetries int, shouldRetry func(*http.Response, error) bool) {
+	if maxRetries <= 0 {
+		z.maxRetries = 0
+		z.shouldRetry = nil
+		return
+	}
+
+	if shouldRetry == nil {
+		shouldRetry = DefaultShouldRetry
+	}
+	z.maxRetries = maxRetries
+	z.shouldRetry = shouldRetry
+}
+
+// DefaultShouldRetry retries 429 responses, which Zendesk sends before processing the request,
+// and 502, 503 and 504 responses to idempotent requests. POST requests are not retried
+// on 5xx because they may have been processed. Errors without a response are not retried.
+func DefaultShouldRetry(resp *http.Response, err error) bool {
+	if err != nil || resp == nil {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return resp.Request != nil && isIdempotent(resp.Request.Method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay returns how long to wait before the retry after attempt
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return retryBaseDelay << uint(attempt)
+}
+
+// rewindBody resets the body of req so it can be sent again,
+// and reports false when the body can't be replayed
+func rewindBody(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
+// discardResponse reads and closes the body of a response which won't be returned,
+// so that the connection can be reused
+func discardResponse(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
@@ -0,0 +1,128 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRetryServiceUnavailable(t *testing.T) {
+	defer func(d time.Duration) { retryBaseDelay = d }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var calls int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	client.SetRetry(3, nil)
+	defer mockAPI.Close()
+
+	if _, err := client.GetTicket(ctx, 2); err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 requests, but got %d", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	defer func(d time.Duration) { retryBaseDelay = d }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var calls int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	client := newTestClient(mockAPI)
+	client.SetRetry(2, nil)
+	defer mockAPI.Close()
+
+	_, err := client.GetTicket(ctx, 2)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected %s, but got %v", ErrRateLimited, err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 requests, but got %d", calls)
+	}
+}
+
+func TestRetryDoesNotRetryPostOnServerError(t *testing.T) {
+	var calls int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	client := newTestClient(mockAPI)
+	client.SetRetry(3, nil)
+	defer mockAPI.Close()
+
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "Help"}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected 1 request, but got %d", calls)
+	}
+}
+
+func TestRetryCustomPredicate(t *testing.T) {
+	defer func(d time.Duration) { retryBaseDelay = d }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var bodies []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	client.SetRetry(1, func(resp *http.Response, err error) bool {
+		return resp != nil && resp.StatusCode == http.StatusBadGateway
+	})
+	defer mockAPI.Close()
+
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "Help"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] == "" {
+		t.Fatalf("expected the same body to be sent twice, but got %q", bodies)
+	}
+}
+
+func TestRetryCanceledContext(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	client := newTestClient(mockAPI)
+	client.SetRetry(3, nil)
+	defer mockAPI.Close()
+
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetTicket(canceled, 2)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %s, but got %v", context.DeadlineExceeded, err)
+	}
+}
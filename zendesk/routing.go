@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RoutingAttribute is an attribute of skills-based routing, such as "Language"
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+type RoutingAttribute struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	URL       string     `json:"url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// RoutingAttributeValue is a value of a routing attribute, such as "Japanese".
+// It's the skill which tickets require and agents have.
+type RoutingAttributeValue struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	AttributeID string     `json:"attribute_id"`
+	URL         string     `json:"url,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// RoutingAPI an interface containing all skills-based routing related methods
+type RoutingAPI interface {
+	GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error)
+	GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error)
+	GetTicketSkills(ctx context.Context, ticketID int64) ([]RoutingAttributeValue, error)
+	GetAgentSkills(ctx context.Context, userID int64) ([]RoutingAttributeValue, error)
+}
+
+// GetRoutingAttributes gets the routing attributes of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-account-attributes
+func (z *Client) GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error) {
+	var data struct {
+		Attributes []RoutingAttribute `json:"attributes"`
+	}
+
+	body, err := z.get(ctx, "/routing/attributes.json")
+	if err != nil {
+		return nil, fmt.Errorf("get routing attributes: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("get routing attributes: %w", err)
+	}
+	return data.Attributes, nil
+}
+
+// GetRoutingAttributeValues gets the values of the routing attribute
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-attribute-values-for-an-attribute
+func (z *Client) GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error) {
+	values, err := z.getRoutingAttributeValues(ctx, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID))
+	if err != nil {
+		return nil, fmt.Errorf("get routing attribute values %s: %w", attributeID, err)
+	}
+	return values, nil
+}
+
+// GetTicketSkills gets the attribute values which the ticket requires
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-ticket-attribute-values
+func (z *Client) GetTicketSkills(ctx context.Context, ticketID int64) ([]RoutingAttributeValue, error) {
+	values, err := z.getRoutingAttributeValues(ctx, fmt.Sprintf("/routing/tickets/%d/instance_values.json", ticketID))
+	if err != nil {
+		return nil, fmt.Errorf("get ticket skills %d: %w", ticketID, err)
+	}
+	return values, nil
+}
+
+// GetAgentSkills gets the attribute values which the agent has
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-agent-attribute-values
+func (z *Client) GetAgentSkills(ctx context.Context, userID int64) ([]RoutingAttributeValue, error) {
+	values, err := z.getRoutingAttributeValues(ctx, fmt.Sprintf("/routing/agents/%d/instance_values.json", userID))
+	if err != nil {
+		return nil, fmt.Errorf("get agent skills %d: %w", userID, err)
+	}
+	return values, nil
+}
+
+func (z *Client) getRoutingAttributeValues(ctx context.Context, path string) ([]RoutingAttributeValue, error) {
+	var data struct {
+		AttributeValues []RoutingAttributeValue `json:"attribute_values"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.AttributeValues, nil
+}
@@ -0,0 +1,59 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRoutingAttributes(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attributes.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attributes, err := client.GetRoutingAttributes(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get routing attributes: %s", err)
+	}
+
+	if len(attributes) != 1 || attributes[0].Name != "Language" {
+		t.Fatalf("unexpected attributes %v", attributes)
+	}
+}
+
+func TestGetRoutingAttributeValues(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "routing_attribute_values.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	calls := map[string]func() ([]RoutingAttributeValue, error){
+		"/routing/attributes/15821cba/values.json": func() ([]RoutingAttributeValue, error) {
+			return client.GetRoutingAttributeValues(ctx, "15821cba")
+		},
+		"/routing/tickets/2/instance_values.json": func() ([]RoutingAttributeValue, error) {
+			return client.GetTicketSkills(ctx, 2)
+		},
+		"/routing/agents/377922500012/instance_values.json": func() ([]RoutingAttributeValue, error) {
+			return client.GetAgentSkills(ctx, 377922500012)
+		},
+	}
+
+	for path, call := range calls {
+		values, err := call()
+		if err != nil {
+			t.Fatalf("Failed to get %s: %s", path, err)
+		}
+
+		if paths[len(paths)-1] != path {
+			t.Fatalf("expected request to %s, but got %s", path, paths[len(paths)-1])
+		}
+		if len(values) != 1 || values[0].Name != "Japanese" || values[0].AttributeID != "15821cba-7326-11e8-b07e-950ba849aa27" {
+			t.Fatalf("unexpected values %v", values)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package zendesk
+
+// RuleDefinition is an action or a condition which can be used in macros or triggers.
+// Type tells which input its value takes, such as "list", "text" or "date".
+// Values lists the allowed values when Type is "list".
+type RuleDefinition struct {
+	Subject    string                   `json:"subject"`
+	Title      string                   `json:"title"`
+	Type       string                   `json:"type"`
+	Group      string                   `json:"group"`
+	Nullable   bool                     `json:"nullable"`
+	Repeatable bool                     `json:"repeatable"`
+	Operators  []RuleDefinitionOperator `json:"operators,omitempty"`
+	Values     []RuleDefinitionValue    `json:"values,omitempty"`
+}
+
+// RuleDefinitionOperator is an operator which can be used in a condition.
+// Terminal operators, such as "changed", take no value.
+type RuleDefinitionOperator struct {
+	Value    string `json:"value"`
+	Title    string `json:"title"`
+	Terminal bool   `json:"terminal"`
+}
+
+// RuleDefinitionValue is an allowed value of an action or a condition
+type RuleDefinitionValue struct {
+	Value   interface{} `json:"value"`
+	Title   string      `json:"title"`
+	Enabled bool        `json:"enabled"`
+}
+
+// MacroDefinitions is the actions available to macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+type MacroDefinitions struct {
+	Actions []RuleDefinition `json:"actions"`
+}
+
+// TriggerDefinitions is the actions and conditions available to triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-action-and-condition-definitions
+type TriggerDefinitions struct {
+	Actions       []RuleDefinition `json:"actions"`
+	ConditionsAll []RuleDefinition `json:"conditions_all"`
+	ConditionsAny []RuleDefinition `json:"conditions_any"`
+}
@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Scores of SatisfactionRating
+const (
+	SatisfactionScoreUnoffered = "unoffered"
+	SatisfactionScoreOffered   = "offered"
+	SatisfactionScoreGood      = "good"
+	SatisfactionScoreBad       = "bad"
+)
+
+// SatisfactionRating is the satisfaction rating of a ticket. Tickets carry it inline,
+// so ticket lists return the ratings without a request per ticket. It is nil for
+// tickets of accounts which don't have satisfaction ratings enabled.
+type SatisfactionRating struct {
+	ID      int64  `json:"id"`
+	Score   string `json:"score"`
+	Comment string `json:"comment"`
+
+	// ReasonID is the ID of the SatisfactionReason the customer chose for a bad rating
+	ReasonID int64 `json:"reason_id,omitempty"`
+}
+
+// Rated reports whether the customer rated the ticket good or bad, as opposed
+// to not having been asked or not having answered yet
+func (r *SatisfactionRating) Rated() bool {
+	return r != nil && (r.Score == SatisfactionScoreGood || r.Score == SatisfactionScoreBad)
+}
+
+// SatisfactionReason is a reason which customers can choose for a bad satisfaction rating
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/
+type SatisfactionReason struct {
+	ID         int64      `json:"id,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	ReasonCode int64      `json:"reason_code,omitempty"`
+	Value      string     `json:"value,omitempty"`
+	RawValue   string     `json:"raw_value,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+// SatisfactionReasonAPI an interface containing all satisfaction reason related methods
+type SatisfactionReasonAPI interface {
+	GetSatisfactionReasons(ctx context.Context) ([]SatisfactionReason, error)
+	GetSatisfactionReason(ctx context.Context, reasonID int64) (SatisfactionReason, error)
+}
+
+// GetSatisfactionReasons gets all satisfaction reasons
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/#list-reasons-for-satisfaction-rating
+func (z *Client) GetSatisfactionReasons(ctx context.Context) ([]SatisfactionReason, error) {
+	var data struct {
+		Reasons []SatisfactionReason `json:"reasons"`
+	}
+
+	body, err := z.get(ctx, "/satisfaction_reasons.json")
+	if err != nil {
+		return nil, fmt.Errorf("get satisfaction reasons: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("get satisfaction reasons: %w", err)
+	}
+	return data.Reasons, nil
+}
+
+// GetSatisfactionReason gets a specified satisfaction reason
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/#show-reason-for-satisfaction-rating
+func (z *Client) GetSatisfactionReason(ctx context.Context, reasonID int64) (SatisfactionReason, error) {
+	var data struct {
+		Reason SatisfactionReason `json:"reason"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/satisfaction_reasons/%d.json", reasonID))
+	if err != nil {
+		return SatisfactionReason{}, fmt.Errorf("get satisfaction reason %d: %w", reasonID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return SatisfactionReason{}, fmt.Errorf("get satisfaction reason %d: %w", reasonID, err)
+	}
+	return data.Reason, nil
+}
@@ -0,0 +1,77 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetSatisfactionReasons(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reasons.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reasons, err := client.GetSatisfactionReasons(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reasons: %s", err)
+	}
+
+	if len(reasons) != 2 {
+		t.Fatalf("expected length of satisfaction reasons is 2, but got %d", len(reasons))
+	}
+
+	if reasons[0].DeletedAt != nil || reasons[1].DeletedAt == nil {
+		t.Fatalf("DeletedAt is not decoded correctly: %v", reasons)
+	}
+}
+
+func TestGetSatisfactionReason(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reason.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reason, err := client.GetSatisfactionReason(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reason: %s", err)
+	}
+
+	if reason.ID != 35436 || reason.ReasonCode != 1000 || reason.Value != "Some other reason" {
+		t.Fatalf("Unexpected satisfaction reason %v", reason)
+	}
+}
+
+func TestSatisfactionRatingReasonID(t *testing.T) {
+	var ticket Ticket
+	data := `{"satisfaction_rating": {"id": 1, "score": "bad", "comment": "slow", "reason_id": 35437}}`
+	if err := json.Unmarshal([]byte(data), &ticket); err != nil {
+		t.Fatalf("Failed to unmarshal ticket: %s", err)
+	}
+
+	if ticket.SatisfactionRating == nil || ticket.SatisfactionRating.ReasonID != 35437 {
+		t.Fatalf("Unexpected satisfaction rating %v", ticket.SatisfactionRating)
+	}
+}
+
+func TestTicketsSatisfactionRating(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+
+	if tickets[0].SatisfactionRating != nil || tickets[0].SatisfactionRating.Rated() {
+		t.Fatalf("expected no satisfaction rating, but got %v", tickets[0].SatisfactionRating)
+	}
+
+	rating := tickets[1].SatisfactionRating
+	if !rating.Rated() || rating.Score != SatisfactionScoreGood || rating.Comment != "Thanks for the quick reply" {
+		t.Fatalf("Unexpected satisfaction rating %v", rating)
+	}
+
+	if (&SatisfactionRating{Score: SatisfactionScoreOffered}).Rated() {
+		t.Fatal("an offered rating should not be rated")
+	}
+}
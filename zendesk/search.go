@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // SearchOptions are the options that can be provided to the search API
@@ -23,9 +24,18 @@ type CountOptions struct {
 	Query string `url:"query"`
 }
 
+// searchExportOptions are the options of the search export API
+type searchExportOptions struct {
+	CursorPaginationOptions
+	Query      string `url:"query"`
+	FilterType string `url:"filter[type]"`
+}
+
 type SearchAPI interface {
 	Search(ctx context.Context, opts *SearchOptions) (SearchResults, Page, error)
 	SearchCount(ctx context.Context, opts *CountOptions) (int, error)
+	ExportSearchTickets(ctx context.Context, query string, opts *CursorPaginationOptions) ([]Ticket, CursorPagination, error)
+	IncrementalOrganizationTicketExport(ctx context.Context, orgID int64, startTime time.Time) ([]Ticket, error)
 }
 
 type SearchResults struct {
@@ -133,17 +143,17 @@ func (z *Client) Search(ctx context.Context, opts *SearchOptions) (SearchResults
 
 	u, err := addOptions("/search.json", opts)
 	if err != nil {
-		return SearchResults{}, Page{}, err
+		return SearchResults{}, Page{}, fmt.Errorf("search: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return SearchResults{}, Page{}, err
+		return SearchResults{}, Page{}, fmt.Errorf("search: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return SearchResults{}, Page{}, err
+		return SearchResults{}, Page{}, fmt.Errorf("search: %w", err)
 	}
 
 	return data.Results, data.Page, nil
@@ -163,18 +173,77 @@ func (z *Client) SearchCount(ctx context.Context, opts *CountOptions) (int, erro
 
 	u, err := addOptions("/search/count.json", opts)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("search count: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("search count: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("search count: %w", err)
 	}
 
 	return data.Count, nil
 }
+
+// ExportSearchTickets gets a page of the tickets matching query with the search export API.
+// Unlike Search, it has no limit on the number of results, but results can't be sorted.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (z *Client) ExportSearchTickets(ctx context.Context, query string, opts *CursorPaginationOptions) ([]Ticket, CursorPagination, error) {
+	var data struct {
+		Results []Ticket         `json:"results"`
+		Meta    CursorPagination `json:"meta"`
+	}
+
+	tmp := searchExportOptions{Query: query, FilterType: "ticket"}
+	if opts != nil {
+		tmp.CursorPaginationOptions = *opts
+	}
+
+	u, err := addOptions("/search/export.json", tmp)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("export search tickets: %w", err)
+	}
+
+	ctx, cancel := z.exportContext(ctx)
+	defer cancel()
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("export search tickets: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("export search tickets: %w", err)
+	}
+
+	return data.Results, data.Meta, nil
+}
+
+// IncrementalOrganizationTicketExport gets all tickets of the organization updated at or after startTime.
+// The incremental export APIs can't be filtered by organization, so this uses the
+// search export API instead. Search results are indexed with a delay of a few minutes,
+// so start the next export a little before the time of the previous one.
+func (z *Client) IncrementalOrganizationTicketExport(ctx context.Context, orgID int64, startTime time.Time) ([]Ticket, error) {
+	query := fmt.Sprintf("organization:%d updated>=%s", orgID, startTime.UTC().Format(time.RFC3339))
+
+	var tickets []Ticket
+	opts := CursorPaginationOptions{}
+	for {
+		page, meta, err := z.ExportSearchTickets(ctx, query, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("incremental organization ticket export %d: %w", orgID, err)
+		}
+		tickets = append(tickets, page...)
+
+		if !meta.HasMore || meta.AfterCursor == "" {
+			return tickets, nil
+		}
+		opts.After = meta.AfterCursor
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestSearchTickets(t *testing.T) {
@@ -136,3 +137,38 @@ func TestSearchQueryParam(t *testing.T) {
 		t.Fatalf("Received error from search api")
 	}
 }
+
+func TestIncrementalOrganizationTicketExport(t *testing.T) {
+	var queries []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/export.json" || r.URL.Query().Get("filter[type]") != "ticket" {
+			t.Errorf("unexpected request %s", r.URL)
+			return
+		}
+		queries = append(queries, r.URL.Query().Get("query"))
+
+		switch r.URL.Query().Get("page[after]") {
+		case "":
+			w.Write([]byte(`{"results": [{"id": 1}, {"id": 2}], "meta": {"has_more": true, "after_cursor": "abc"}}`))
+		case "abc":
+			w.Write([]byte(`{"results": [{"id": 3}], "meta": {"has_more": false, "after_cursor": "def"}}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("page[after]"))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	startTime := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	tickets, err := client.IncrementalOrganizationTicketExport(ctx, 123, startTime)
+	if err != nil {
+		t.Fatalf("Failed to export organization tickets: %s", err)
+	}
+
+	if len(tickets) != 3 || tickets[2].ID != 3 {
+		t.Fatalf("unexpected tickets %v", tickets)
+	}
+	if len(queries) != 2 || queries[0] != "organization:123 updated>=2021-03-04T05:06:07Z" {
+		t.Fatalf("unexpected queries %v", queries)
+	}
+}
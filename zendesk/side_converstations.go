@@ -1,26 +1,34 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
 )
 
 type SideConversation struct {
-	CreatedAt      time.Time      `json:"created_at,omitempty"`
+	CreatedAt      ZendeskTime    `json:"created_at,omitempty"`
 	ID             string         `json:"id,omitempty"`
-	MessageAddedAt time.Time      `json:"message_added_at,omitempty"`
+	MessageAddedAt ZendeskTime    `json:"message_added_at,omitempty"`
 	Participants   []Participants `json:"participants,omitempty"`
 	PreviewText    string         `json:"preview_text,omitempty"`
 	State          string         `json:"state,omitempty"`
-	StateUpdatedAt time.Time      `json:"state_updated_at,omitempty"`
+	StateUpdatedAt ZendeskTime    `json:"state_updated_at,omitempty"`
 	Subject        string         `json:"subject,omitempty"`
 	TicketID       int64          `json:"ticket_id,omitempty"`
-	UpdatedAt      time.Time      `json:"updated_at,omitempty"`
+	UpdatedAt      ZendeskTime    `json:"updated_at,omitempty"`
 	URL            string         `json:"url,omitempty"`
 }
 
+// Message is a message of a side conversation. Unlike ticket comments, messages
+// have no public flag: who sees a message depends on the recipients, and messages
+// to a child ticket (see NewChildTicketRecipient) stay internal to Zendesk.
+// ExternalIDs is kept by Zendesk and can be used to correlate messages with another system.
 type Message struct {
 	Subject     string            `json:"subject,omitempty"`
 	PreviewText string            `json:"preview_text,omitempty"`
@@ -29,6 +37,9 @@ type Message struct {
 	From        map[string]string `json:"from,omitempty"`
 	To          []MessageTo       `json:"to,omitempty"`
 	ExternalIDs map[string]string `json:"external_ids,omitempty"`
+
+	// AttachmentIDs are IDs of files uploaded by UploadSideConversationAttachment
+	AttachmentIDs []string `json:"attachment_ids,omitempty"`
 }
 
 type Participants struct {
@@ -41,9 +52,144 @@ type ExternalIDs struct {
 	MySystemID string `json:"my_system_id,omitempty"`
 }
 
+// MessageTo is a recipient of a side conversation message. Zendesk picks the
+// channel of the side conversation from the recipients, so set the fields of
+// one context only: Email for email, SupportGroupID or SupportAgentID for a
+// child ticket, SlackWorkspaceID and SlackChannelID for Slack, MSTeamsChannelID
+// for Microsoft Teams. Slack and Microsoft Teams must be connected to the account.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#create-side-conversation
 type MessageTo struct {
 	Email string `json:"email,omitempty"`
 	Name  string `json:"name,omitempty"`
+
+	SupportGroupID int64 `json:"support_group_id,omitempty"`
+	SupportAgentID int64 `json:"support_agent_id,omitempty"`
+
+	SlackWorkspaceID string `json:"slack_workspace_id,omitempty"`
+	SlackChannelID   string `json:"slack_channel_id,omitempty"`
+
+	MSTeamsChannelID string `json:"msteams_channel_id,omitempty"`
+}
+
+// Context types of a side conversation, the same as TicketSideConversation.ContextType
+const (
+	SideConversationContextEmail = "email"
+	SideConversationContextChild = "child"
+	SideConversationContextSlack = "slack"
+
+	SideConversationContextMSTeams = "msteams"
+)
+
+// ContextType returns the context type of the side conversation which the recipient opens
+func (m MessageTo) ContextType() string {
+	switch {
+	case m.SupportGroupID != 0 || m.SupportAgentID != 0:
+		return SideConversationContextChild
+	case m.SlackChannelID != "":
+		return SideConversationContextSlack
+	case m.MSTeamsChannelID != "":
+		return SideConversationContextMSTeams
+	default:
+		return SideConversationContextEmail
+	}
+}
+
+// NewChildTicketRecipient returns a recipient which opens a child ticket side conversation
+// assigned to the group, or to the agent if agentID is not 0
+func NewChildTicketRecipient(groupID, agentID int64) MessageTo {
+	return MessageTo{SupportGroupID: groupID, SupportAgentID: agentID}
+}
+
+// NewSlackRecipient returns a recipient which opens a side conversation in the Slack channel
+func NewSlackRecipient(workspaceID, channelID string) MessageTo {
+	return MessageTo{SlackWorkspaceID: workspaceID, SlackChannelID: channelID}
+}
+
+// NewMSTeamsRecipient returns a recipient which opens a side conversation in the Microsoft Teams channel
+func NewMSTeamsRecipient(channelID string) MessageTo {
+	return MessageTo{MSTeamsChannelID: channelID}
+}
+
+// States of a side conversation
+const (
+	SideConversationStateOpen   = "open"
+	SideConversationStateClosed = "closed"
+)
+
+// SideConversationListOptions is options for GetSideConversations
+type SideConversationListOptions struct {
+	PageOptions
+
+	// State filters side conversations by SideConversationStateOpen or
+	// SideConversationStateClosed. The API doesn't support filtering or
+	// sorting, so State is applied to each page after it is fetched.
+	State string `url:"-"`
+}
+
+// SideConversationAPI an interface containing all side conversation related methods
+type SideConversationAPI interface {
+	GetSideConversations(ctx context.Context, ticketID int64, opts *SideConversationListOptions) ([]SideConversation, Page, error)
+	CreateSideConversation(ctx context.Context, ticketID int64, m Message) (SideConversation, error)
+	ReplySideConversation(ctx context.Context, ticketID int64, sideConversationID string, m Message) (SideConversation, error)
+	GetSideConversationEvents(ctx context.Context, ticketID int64, sideConversationID string) ([]SideConversationEvent, error)
+	UploadSideConversationAttachment(ctx context.Context, filename string, r io.Reader) (SideConversationAttachment, error)
+	UploadSideConversationAttachmentWithProgress(ctx context.Context, filename string, r io.Reader, size int64, progress func(bytesSent int64)) (SideConversationAttachment, error)
+	DeleteSideConversationAttachment(ctx context.Context, attachmentID string) error
+}
+
+// SideConversationAttachment is a file uploaded for side conversation messages.
+// Pass its ID in Message.AttachmentIDs to attach it to a message.
+type SideConversationAttachment struct {
+	ID          string `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	ContentURL  string `json:"content_url,omitempty"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+}
+
+// GetSideConversations gets side conversations of the specified ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#list-side-conversations
+func (z *Client) GetSideConversations(ctx context.Context, ticketID int64, opts *SideConversationListOptions) ([]SideConversation, Page, error) {
+	var data struct {
+		SideConversations []SideConversation `json:"side_conversations"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SideConversationListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/side_conversations", ticketID), tmp)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get side conversations %d: %w", ticketID, err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get side conversations %d: %w", ticketID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get side conversations %d: %w", ticketID, err)
+	}
+
+	if tmp.State == "" {
+		return data.SideConversations, data.Page, nil
+	}
+
+	var filtered []SideConversation
+	for _, sc := range data.SideConversations {
+		if sc.State == tmp.State {
+			filtered = append(filtered, sc)
+		}
+	}
+	return filtered, data.Page, nil
 }
 
 // CreateSideConversation create a new side conversation
@@ -57,7 +203,7 @@ func (z *Client) CreateSideConversation(ctx context.Context, ticketID int64, m M
 
 	body, err := z.post(ctx, fmt.Sprintf("/tickets/%d/side_conversations", ticketID), request)
 	if err != nil {
-		return SideConversation{}, err
+		return SideConversation{}, fmt.Errorf("create side conversation %d: %w", ticketID, err)
 	}
 
 	var result struct {
@@ -66,7 +212,218 @@ func (z *Client) CreateSideConversation(ctx context.Context, ticketID int64, m M
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return SideConversation{}, err
+		return SideConversation{}, fmt.Errorf("create side conversation %d: %w", ticketID, err)
 	}
 	return result.SideConversation, nil
 }
+
+// ReplySideConversation adds a message to an existing side conversation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation/#reply-to-side-conversation
+func (z *Client) ReplySideConversation(ctx context.Context, ticketID int64, sideConversationID string, m Message) (SideConversation, error) {
+	var request struct {
+		Message Message `json:"message"`
+	}
+	request.Message = m
+
+	body, err := z.post(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s/reply", ticketID, sideConversationID), request)
+	if err != nil {
+		return SideConversation{}, fmt.Errorf("reply side conversation %s: %w", sideConversationID, err)
+	}
+
+	var result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, fmt.Errorf("reply side conversation %s: %w", sideConversationID, err)
+	}
+	return result.SideConversation, nil
+}
+
+// SideConversationEvent is an event of a side conversation, such as a message
+// sent or received, or an update of its state. Zendesk doesn't report whether
+// a message was delivered to the participants, so a bounce of an email message
+// appears only as a reply from the mailer daemon, if at all.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_event/
+type SideConversationEvent struct {
+	ID                 string                 `json:"id"`
+	SideConversationID string                 `json:"side_conversation_id"`
+	TicketID           int64                  `json:"ticket_id"`
+	Actor              Participants           `json:"actor"`
+	Type               string                 `json:"type"`
+	Via                string                 `json:"via"`
+	CreatedAt          ZendeskTime            `json:"created_at"`
+	Message            *EventMessage          `json:"message,omitempty"`
+	Updates            map[string]interface{} `json:"updates,omitempty"`
+}
+
+// EventMessage is the message of a SideConversationEvent. Unlike Message,
+// its sender and recipients are participants with their user IDs.
+type EventMessage struct {
+	Subject     string                       `json:"subject"`
+	PreviewText string                       `json:"preview_text"`
+	Body        string                       `json:"body"`
+	HTMLBody    string                       `json:"html_body"`
+	From        Participants                 `json:"from"`
+	To          []Participants               `json:"to"`
+	ExternalIDs map[string]string            `json:"external_ids,omitempty"`
+	Attachments []SideConversationAttachment `json:"attachments,omitempty"`
+}
+
+// GetSideConversationEvents gets the events of a side conversation, oldest first
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_event/#list-side-conversation-events
+func (z *Client) GetSideConversationEvents(ctx context.Context, ticketID int64, sideConversationID string) ([]SideConversationEvent, error) {
+	var data struct {
+		Events []SideConversationEvent `json:"events"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s/events", ticketID, sideConversationID))
+	if err != nil {
+		return nil, fmt.Errorf("get side conversation events %s: %w", sideConversationID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, fmt.Errorf("get side conversation events %s: %w", sideConversationID, err)
+	}
+	return data.Events, nil
+}
+
+// UploadSideConversationAttachment uploads a file which can be attached to side conversation messages.
+// Files which are never attached to a message are deleted by Zendesk after a while,
+// or can be removed with DeleteSideConversationAttachment.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_attachment/#upload-files
+func (z *Client) UploadSideConversationAttachment(ctx context.Context, filename string, r io.Reader) (SideConversationAttachment, error) {
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	if err := form.Close(); err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+
+	attachment, err := z.sendSideConversationAttachment(ctx, &buf, int64(buf.Len()), form.FormDataContentType())
+	if err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	return attachment, nil
+}
+
+// UploadSideConversationAttachmentWithProgress is UploadSideConversationAttachment for
+// large files. The file is streamed from r instead of being read into memory first,
+// and progress, when not nil, is called with the number of bytes of the file sent so far.
+// Pass the size of the file, or -1 when it is unknown, in which case the request is
+// sent with chunked encoding. A streamed upload can't be sent again, so it is not
+// retried as configured by SetRetry.
+func (z *Client) UploadSideConversationAttachmentWithProgress(ctx context.Context, filename string, r io.Reader, size int64, progress func(bytesSent int64)) (SideConversationAttachment, error) {
+	// The multipart header and trailer are built up front so that the
+	// length of the body is known and only the file itself is streamed
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	if _, err := form.CreateFormFile("file", filename); err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	header := append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+	if err := form.Close(); err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	trailer := buf.Bytes()
+
+	file := r
+	if progress != nil {
+		file = &progressReader{r: r, progress: progress}
+	}
+	body := io.MultiReader(bytes.NewReader(header), file, bytes.NewReader(trailer))
+
+	contentLength := int64(-1)
+	if size >= 0 {
+		contentLength = int64(len(header)) + size + int64(len(trailer))
+	}
+
+	attachment, err := z.sendSideConversationAttachment(ctx, body, contentLength, form.FormDataContentType())
+	if err != nil {
+		return SideConversationAttachment{}, fmt.Errorf("upload side conversation attachment %s: %w", filename, err)
+	}
+	return attachment, nil
+}
+
+// progressReader reports how many bytes were read from r
+type progressReader struct {
+	r        io.Reader
+	read     int64
+	progress func(bytesSent int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.read += int64(n)
+		p.progress(p.read)
+	}
+	return n, err
+}
+
+// sendSideConversationAttachment posts a multipart body with a file to the
+// attachments endpoint. contentLength is -1 when the length is unknown.
+func (z *Client) sendSideConversationAttachment(ctx context.Context, body io.Reader, contentLength int64, contentType string) (SideConversationAttachment, error) {
+	req, err := http.NewRequest(http.MethodPost, z.baseURL.String()+"/tickets/side_conversations/attachments", body)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+	if contentLength >= 0 {
+		req.ContentLength = contentLength
+	}
+
+	req = z.prepareRequest(ctx, req)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := z.do(req)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated) {
+		return SideConversationAttachment{}, Error{
+			body: respBody,
+			resp: resp,
+		}
+	}
+
+	var result struct {
+		Attachment SideConversationAttachment `json:"attachment"`
+	}
+
+	err = json.Unmarshal(respBody, &result)
+	if err != nil {
+		return SideConversationAttachment{}, err
+	}
+	return result.Attachment, nil
+}
+
+// DeleteSideConversationAttachment deletes a file uploaded by UploadSideConversationAttachment
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/side_conversation/side_conversation_attachment/#delete-upload
+func (z *Client) DeleteSideConversationAttachment(ctx context.Context, attachmentID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/tickets/side_conversations/attachments/%s", attachmentID))
+	if err != nil {
+		return fmt.Errorf("delete side conversation attachment %s: %w", attachmentID, err)
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCreateSideConversationWithAttachments(t *testing.T) {
+	var payload, file string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/side_conversations/attachments":
+			f, header, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("Failed to read uploaded file: %s", err)
+				return
+			}
+			content, _ := ioutil.ReadAll(f)
+			file = header.Filename + ":" + string(content)
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation_attachment.json")))
+		case "/tickets/2/side_conversations":
+			body, _ := ioutil.ReadAll(r.Body)
+			payload = string(body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"side_conversation": {"id": "8566255a", "ticket_id": 2}}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.UploadSideConversationAttachment(ctx, "invoice.pdf", strings.NewReader("%PDF"))
+	if err != nil {
+		t.Fatalf("Failed to upload attachment: %s", err)
+	}
+
+	if file != "invoice.pdf:%PDF" {
+		t.Fatalf("Unexpected uploaded file %q", file)
+	}
+
+	_, err = client.CreateSideConversation(ctx, 2, Message{
+		Subject:       "Invoice",
+		Body:          "See the attached invoice",
+		To:            []MessageTo{{Email: "billing@example.com"}},
+		AttachmentIDs: []string{attachment.ID},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	expected := `{"message":{"subject":"Invoice","body":"See the attached invoice","to":[{"email":"billing@example.com"}],"attachment_ids":["0b3ba8a8-60f1-4b24-9a8f-a47e3a8c2b8f"]}}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
+
+func TestUploadSideConversationAttachmentWithProgress(t *testing.T) {
+	content := strings.Repeat("0123456789", 10000)
+
+	for _, size := range []int64{int64(len(content)), -1} {
+		var file string
+		var contentLength int64
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			contentLength = r.ContentLength
+			f, header, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("Failed to read form file: %s", err)
+				return
+			}
+			defer f.Close()
+			body, _ := ioutil.ReadAll(f)
+			file = header.Filename + ":" + string(body)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation_attachment.json")))
+		}))
+		client := newTestClient(mockAPI)
+
+		var sent []int64
+		attachment, err := client.UploadSideConversationAttachmentWithProgress(ctx, "log.txt", strings.NewReader(content), size, func(bytesSent int64) {
+			sent = append(sent, bytesSent)
+		})
+		mockAPI.Close()
+		if err != nil {
+			t.Fatalf("Failed to upload attachment: %s", err)
+		}
+
+		if attachment.ID == "" || file != "log.txt:"+content {
+			t.Fatalf("Unexpected uploaded file of size %d: %d bytes", size, len(file))
+		}
+		if size >= 0 && contentLength <= size {
+			t.Fatalf("expected the content length to include the file, but got %d", contentLength)
+		}
+		if size < 0 && contentLength != -1 {
+			t.Fatalf("expected an unknown content length, but got %d", contentLength)
+		}
+
+		if len(sent) == 0 || sent[len(sent)-1] != int64(len(content)) {
+			t.Fatalf("expected progress up to %d bytes, but got %v", len(content), sent)
+		}
+		for i := 1; i < len(sent); i++ {
+			if sent[i] <= sent[i-1] {
+				t.Fatalf("progress should increase: %v", sent)
+			}
+		}
+	}
+}
+
+func TestDeleteSideConversationAttachment(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteSideConversationAttachment(ctx, "0b3ba8a8"); err != nil {
+		t.Fatalf("Failed to delete attachment: %s", err)
+	}
+
+	if path != "/tickets/side_conversations/attachments/0b3ba8a8" {
+		t.Fatalf("Unexpected request path %s", path)
+	}
+}
+
+func TestMessageAttachmentIDsRoundTrip(t *testing.T) {
+	data := []byte(`{"body":"hello","attachment_ids":["token1","token2"]}`)
+
+	var m Message
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("Failed to unmarshal message: %s", err)
+	}
+
+	out, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %s", err)
+	}
+
+	if string(out) != string(data) {
+		t.Fatalf("expected %s, but got %s", data, out)
+	}
+}
+
+func TestGetSideConversations(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write(readFixture(filepath.Join(http.MethodGet, "side_conversations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	scs, _, err := client.GetSideConversations(ctx, 2, nil)
+	if err != nil {
+		t.Fatalf("Failed to get side conversations: %s", err)
+	}
+
+	if path != "/tickets/2/side_conversations" {
+		t.Fatalf("Unexpected path %s", path)
+	}
+
+	if len(scs) != 2 {
+		t.Fatalf("expected length of side conversations is 2, but got %d", len(scs))
+	}
+
+	open, _, err := client.GetSideConversations(ctx, 2, &SideConversationListOptions{State: SideConversationStateOpen})
+	if err != nil {
+		t.Fatalf("Failed to get side conversations: %s", err)
+	}
+
+	if len(open) != 1 || open[0].State != SideConversationStateOpen {
+		t.Fatalf("Side conversations should be filtered by state: %v", open)
+	}
+}
+
+func TestCreateChildSideConversation(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"side_conversation": {"id": "8566255a", "ticket_id": 2}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateSideConversation(ctx, 2, Message{
+		Subject: "Refund",
+		Body:    "Please refund the order",
+		To:      []MessageTo{NewChildTicketRecipient(360004077472, 0)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	expected := `{"message":{"subject":"Refund","body":"Please refund the order","to":[{"support_group_id":360004077472}]}}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
+
+func TestCreateSlackSideConversation(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"side_conversation": {"id": "8566255a", "ticket_id": 2}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateSideConversation(ctx, 2, Message{
+		Body: "Payments API is down",
+		To:   []MessageTo{NewSlackRecipient("T0123", "C0456")},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	expected := `{"message":{"body":"Payments API is down","to":[{"slack_workspace_id":"T0123","slack_channel_id":"C0456"}]}}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
+
+func TestMessageToContextType(t *testing.T) {
+	cases := []struct {
+		to       MessageTo
+		expected string
+	}{
+		{MessageTo{Email: "billing@example.com"}, SideConversationContextEmail},
+		{NewChildTicketRecipient(0, 377922500013), SideConversationContextChild},
+		{NewSlackRecipient("T0123", "C0456"), SideConversationContextSlack},
+		{NewMSTeamsRecipient("19:abc@thread.tacv2"), SideConversationContextMSTeams},
+	}
+
+	for _, c := range cases {
+		if got := c.to.ContextType(); got != c.expected {
+			t.Fatalf("expected context type of %v is %s, but got %s", c.to, c.expected, got)
+		}
+	}
+}
+
+func TestSideConversationMessageExternalIDs(t *testing.T) {
+	var paths []string
+	var messages []Message
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Message Message `json:"message"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		messages = append(messages, data.Message)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"side_conversation": {"id": "8566255a", "ticket_id": 2}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	m := Message{
+		Subject:     "Order 1234",
+		Body:        "Could you check the shipping status?",
+		To:          []MessageTo{{Email: "warehouse@example.com"}},
+		ExternalIDs: map[string]string{"warehouse_ticket": "WH-1234"},
+	}
+
+	sc, err := client.CreateSideConversation(ctx, 2, m)
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	if _, err := client.ReplySideConversation(ctx, 2, sc.ID, Message{Body: "Any news?", ExternalIDs: m.ExternalIDs}); err != nil {
+		t.Fatalf("Failed to reply side conversation: %s", err)
+	}
+
+	expectedPaths := []string{"/tickets/2/side_conversations", "/tickets/2/side_conversations/8566255a/reply"}
+	if !reflect.DeepEqual(paths, expectedPaths) {
+		t.Fatalf("expected paths %v, but got %v", expectedPaths, paths)
+	}
+
+	for _, sent := range messages {
+		if !reflect.DeepEqual(sent.ExternalIDs, m.ExternalIDs) {
+			t.Fatalf("expected external ids %v, but got %v", m.ExternalIDs, sent.ExternalIDs)
+		}
+	}
+}
+
+func TestGetSideConversationEvents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/2/side_conversations/8566255a-8a8d-11ea-9b5e-9fc4d9a6bd5c/events" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "side_conversation_events.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, err := client.GetSideConversationEvents(ctx, 2, "8566255a-8a8d-11ea-9b5e-9fc4d9a6bd5c")
+	if err != nil {
+		t.Fatalf("Failed to get side conversation events: %s", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected length of events is 2, but got %d", len(events))
+	}
+
+	created := events[0]
+	if created.Type != "create" || created.Message == nil || created.Message.From.UserID != 377922500012 {
+		t.Fatalf("unexpected create event %+v", created)
+	}
+	if len(created.Message.To) != 1 || created.Message.To[0].Email != "warehouse@example.com" {
+		t.Fatalf("unexpected recipients %+v", created.Message.To)
+	}
+
+	if events[1].Message != nil || events[1].Updates["state"] != "closed" {
+		t.Fatalf("unexpected update event %+v", events[1])
+	}
+}
@@ -87,17 +87,17 @@ func (z *Client) GetSLAPolicies(ctx context.Context, opts *SLAPolicyListOptions)
 
 	u, err := addOptions("/slas/policies.json", opts)
 	if err != nil {
-		return []SLAPolicy{}, Page{}, err
+		return []SLAPolicy{}, Page{}, fmt.Errorf("get sla policies: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []SLAPolicy{}, Page{}, err
+		return []SLAPolicy{}, Page{}, fmt.Errorf("get sla policies: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []SLAPolicy{}, Page{}, err
+		return []SLAPolicy{}, Page{}, fmt.Errorf("get sla policies: %w", err)
 	}
 
 	return data.SLAPolicies, data.Page, nil
@@ -115,12 +115,12 @@ func (z *Client) CreateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) (SLAP
 
 	body, err := z.post(ctx, "/slas/policies.json", data)
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("create sla policy: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("create sla policy: %w", err)
 	}
 
 	return result.SLAPolicy, nil
@@ -136,12 +136,12 @@ func (z *Client) GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 
 	body, err := z.get(ctx, fmt.Sprintf("/slas/policies/%d.json", id))
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("get sla policy %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("get sla policy %d: %w", id, err)
 	}
 
 	return result.SLAPolicy, nil
@@ -159,12 +159,12 @@ func (z *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPol
 
 	body, err := z.put(ctx, fmt.Sprintf("/slas/policies/%d.json", id), data)
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("update sla policy %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return SLAPolicy{}, err
+		return SLAPolicy{}, fmt.Errorf("update sla policy %d: %w", id, err)
 	}
 
 	return result.SLAPolicy, nil
@@ -176,7 +176,7 @@ func (z *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPol
 func (z *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/slas/policies/%d.json", id))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete sla policy %d: %w", id, err)
 	}
 
 	return nil
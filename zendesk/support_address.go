@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Forwarding statuses of SupportAddress
+const (
+	ForwardingStatusUnknown  = "unknown"
+	ForwardingStatusWaiting  = "waiting"
+	ForwardingStatusVerified = "verified"
+	ForwardingStatusFailed   = "failed"
+)
+
+// SupportAddress is an email address which receives tickets for a brand
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+type SupportAddress struct {
+	ID                       int64      `json:"id,omitempty"`
+	URL                      string     `json:"url,omitempty"`
+	BrandID                  int64      `json:"brand_id,omitempty"`
+	Default                  bool       `json:"default,omitempty"`
+	Email                    string     `json:"email"`
+	Name                     string     `json:"name,omitempty"`
+	ForwardingStatus         string     `json:"forwarding_status,omitempty"`
+	SPFStatus                string     `json:"spf_status,omitempty"`
+	CNAMEStatus              string     `json:"cname_status,omitempty"`
+	DomainVerificationStatus string     `json:"domain_verification_status,omitempty"`
+	CreatedAt                *time.Time `json:"created_at,omitempty"`
+	UpdatedAt                *time.Time `json:"updated_at,omitempty"`
+}
+
+// ErrUnknownRecipient is returned by ValidateRecipient for an email address which
+// is not a support address of the account
+var ErrUnknownRecipient = errors.New("zendesk: recipient is not a support address")
+
+// SupportAddressAPI an interface containing all support address related methods
+type SupportAddressAPI interface {
+	GetSupportAddresses(ctx context.Context) ([]SupportAddress, error)
+	ValidateRecipient(ctx context.Context, email string) (SupportAddress, error)
+}
+
+// GetSupportAddresses gets all support addresses of the account.
+// Pages are cached when SetCacheTTL is set, as addresses rarely change.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#list-support-addresses
+func (z *Client) GetSupportAddresses(ctx context.Context) ([]SupportAddress, error) {
+	opts := PageOptions{PerPage: maxPerPage, Page: 1}
+
+	var addresses []SupportAddress
+	for {
+		var data struct {
+			RecipientAddresses []SupportAddress `json:"recipient_addresses"`
+			Page
+		}
+
+		u, err := addOptions("/recipient_addresses.json", opts)
+		if err != nil {
+			return nil, fmt.Errorf("get support addresses: %w", err)
+		}
+
+		body, err := z.getCached(ctx, u)
+		if err != nil {
+			return nil, fmt.Errorf("get support addresses: %w", err)
+		}
+
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return nil, fmt.Errorf("get support addresses: %w", err)
+		}
+		addresses = append(addresses, data.RecipientAddresses...)
+
+		if !data.Page.HasNext() {
+			return addresses, nil
+		}
+		opts.Page++
+	}
+}
+
+// ValidateRecipient returns the support address with email, which is compared
+// case-insensitively, so that a ticket's Recipient can be checked before the
+// ticket is created. It returns ErrUnknownRecipient when there is none.
+// The forwarding status is not checked: a ticket can be created for an address
+// whose forwarding is not verified yet.
+func (z *Client) ValidateRecipient(ctx context.Context, email string) (SupportAddress, error) {
+	addresses, err := z.GetSupportAddresses(ctx)
+	if err != nil {
+		return SupportAddress{}, fmt.Errorf("validate recipient %s: %w", email, err)
+	}
+
+	for _, address := range addresses {
+		if strings.EqualFold(address.Email, strings.TrimSpace(email)) {
+			return address, nil
+		}
+	}
+	return SupportAddress{}, fmt.Errorf("validate recipient %s: %w", email, ErrUnknownRecipient)
+}
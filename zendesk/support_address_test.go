@@ -0,0 +1,46 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetSupportAddresses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "recipient_addresses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	addresses, err := client.GetSupportAddresses(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get support addresses: %s", err)
+	}
+
+	if len(addresses) != 2 {
+		t.Fatalf("expected length of support addresses is 2, but got %d", len(addresses))
+	}
+
+	billing := addresses[1]
+	if billing.Email != "billing@example.com" || billing.BrandID != 360002256673 || billing.Default || billing.ForwardingStatus != ForwardingStatusVerified {
+		t.Fatalf("Unexpected support address %+v", billing)
+	}
+}
+
+func TestValidateRecipient(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "recipient_addresses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	address, err := client.ValidateRecipient(ctx, "Billing@Example.com")
+	if err != nil {
+		t.Fatalf("Failed to validate recipient: %s", err)
+	}
+	if address.ID != 360000434032 {
+		t.Fatalf("Unexpected support address %+v", address)
+	}
+
+	_, err = client.ValidateRecipient(ctx, "sales@example.com")
+	if !errors.Is(err, ErrUnknownRecipient) {
+		t.Fatalf("expected ErrUnknownRecipient, but got %v", err)
+	}
+}
@@ -17,6 +17,7 @@ type TagAPI interface {
 	AddTicketTags(ctx context.Context, ticketID int64, tags []Tag) ([]Tag, error)
 	AddOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) ([]Tag, error)
 	AddUserTags(ctx context.Context, userID int64, tags []Tag) ([]Tag, error)
+	TagTicketsMatching(ctx context.Context, query string, tags []string, wait bool) ([]JobStatus, error)
 }
 
 // GetTicketTags get ticket tag list
@@ -29,12 +30,12 @@ func (z *Client) GetTicketTags(ctx context.Context, ticketID int64) ([]Tag, erro
 
 	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/tags.json", ticketID))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get ticket tags %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get ticket tags %d: %w", ticketID, err)
 	}
 
 	return result.Tags, err
@@ -50,12 +51,12 @@ func (z *Client) GetOrganizationTags(ctx context.Context, organizationID int64)
 
 	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/tags.json", organizationID))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get organization tags %d: %w", organizationID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get organization tags %d: %w", organizationID, err)
 	}
 
 	return result.Tags, err
@@ -71,12 +72,12 @@ func (z *Client) GetUserTags(ctx context.Context, userID int64) ([]Tag, error) {
 
 	body, err := z.get(ctx, fmt.Sprintf("/users/%d/tags.json", userID))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get user tags %d: %w", userID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get user tags %d: %w", userID, err)
 	}
 
 	return result.Tags, err
@@ -93,12 +94,12 @@ func (z *Client) AddTicketTags(ctx context.Context, ticketID int64, tags []Tag)
 
 	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d/tags", ticketID), data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add ticket tags %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add ticket tags %d: %w", ticketID, err)
 	}
 	return result.Tags, nil
 }
@@ -114,12 +115,12 @@ func (z *Client) AddOrganizationTags(ctx context.Context, organizationID int64,
 
 	body, err := z.put(ctx, fmt.Sprintf("/organizations/%d/tags", organizationID), data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add organization tags %d: %w", organizationID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add organization tags %d: %w", organizationID, err)
 	}
 	return result.Tags, nil
 }
@@ -135,12 +136,47 @@ func (z *Client) AddUserTags(ctx context.Context, userID int64, tags []Tag) ([]T
 
 	body, err := z.put(ctx, fmt.Sprintf("/users/%d/tags", userID), data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add user tags %d: %w", userID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("add user tags %d: %w", userID, err)
 	}
 	return result.Tags, nil
 }
+
+// TagTicketsMatching adds tags to every ticket matching the search query.
+// The tickets are collected with the search export API, which has no limit on the
+// number of results, and only tickets are matched.
+// The tickets are updated with additional_tags in batches of 100, so tags
+// already on the tickets are kept. A job status is returned for each batch.
+// When wait is true, each job is polled until it is done before the next
+// batch is sent.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) TagTicketsMatching(ctx context.Context, query string, tags []string, wait bool) ([]JobStatus, error) {
+	var ids []int64
+	opts := CursorPaginationOptions{}
+	for {
+		tickets, meta, err := z.ExportSearchTickets(ctx, query, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("tag tickets matching %q: %w", query, err)
+		}
+
+		for _, ticket := range tickets {
+			ids = append(ids, ticket.ID)
+		}
+
+		if !meta.HasMore || meta.AfterCursor == "" {
+			break
+		}
+		opts.After = meta.AfterCursor
+	}
+
+	jobs, err := z.updateManyTicketsInBatches(ctx, ids, Ticket{AdditionalTags: tags}, wait)
+	if err != nil {
+		return jobs, fmt.Errorf("tag tickets matching %q: %w", query, err)
+	}
+	return jobs, nil
+}
@@ -1,7 +1,12 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -115,3 +120,65 @@ func TestAddUserTags(t *testing.T) {
 		t.Fatalf("Returned tags does not have the expexted tag %s. %s given", "important", tags[0])
 	}
 }
+
+func TestTagTicketsMatching(t *testing.T) {
+	var (
+		queries []string
+		batches []string
+		polled  int
+	)
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/search/export.json":
+			queries = append(queries, r.URL.Query().Get("query"))
+			if r.URL.Query().Get("filter[type]") != "ticket" {
+				t.Errorf("unexpected filter %s", r.URL.Query().Get("filter[type]"))
+			}
+			if r.URL.Query().Get("page[after]") == "" {
+				w.Write([]byte(`{"results": [{"id": 4}, {"id": 5}], "meta": {"has_more": true, "after_cursor": "abc"}}`))
+				return
+			}
+			w.Write([]byte(`{"results": [{"id": 6}], "meta": {"has_more": false, "after_cursor": "def"}}`))
+		case strings.HasSuffix(r.URL.Path, "/tickets/update_many.json"):
+			var payload map[string]map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("Failed to decode request body: %s", err)
+				return
+			}
+			if !reflect.DeepEqual(payload["ticket"]["additional_tags"], []interface{}{"bulk"}) {
+				t.Errorf("Unexpected payload %v", payload)
+				return
+			}
+			batches = append(batches, r.URL.Query().Get("ids"))
+			w.Write(readFixture(filepath.Join(http.MethodPut, "update_many.json")))
+		case strings.Contains(r.URL.Path, "/job_statuses/"):
+			polled++
+			w.Write(readFixture(filepath.Join(http.MethodGet, "job_status.json")))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	interval := jobPollInterval
+	jobPollInterval = 0
+	defer func() { jobPollInterval = interval }()
+
+	jobs, err := client.TagTicketsMatching(ctx, "status:open", []string{"bulk"}, true)
+	if err != nil {
+		t.Fatalf("Failed to tag tickets: %s", err)
+	}
+
+	if !reflect.DeepEqual(queries, []string{"status:open", "status:open"}) {
+		t.Fatalf("Unexpected queries %v", queries)
+	}
+
+	if !reflect.DeepEqual(batches, []string{"4,5,6"}) {
+		t.Fatalf("Unexpected batches %v", batches)
+	}
+
+	if len(jobs) != 1 || jobs[0].Status != JobStatusCompleted || polled != 1 {
+		t.Fatalf("Jobs should have been waited for. jobs: %v, polled: %d", jobs, polled)
+	}
+}
@@ -45,12 +45,12 @@ func (z *Client) GetTargets(ctx context.Context) ([]Target, Page, error) {
 
 	body, err := z.get(ctx, "/targets.json")
 	if err != nil {
-		return []Target{}, Page{}, err
+		return []Target{}, Page{}, fmt.Errorf("get targets: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []Target{}, Page{}, err
+		return []Target{}, Page{}, fmt.Errorf("get targets: %w", err)
 	}
 
 	return data.Targets, data.Page, nil
@@ -67,12 +67,12 @@ func (z *Client) CreateTarget(ctx context.Context, target Target) (Target, error
 
 	body, err := z.post(ctx, "/targets.json", data)
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("create target: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("create target: %w", err)
 	}
 
 	return result.Target, nil
@@ -88,12 +88,12 @@ func (z *Client) GetTarget(ctx context.Context, targetID int64) (Target, error)
 	body, err := z.get(ctx, fmt.Sprintf("/targets/%d.json", targetID))
 
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("get target %d: %w", targetID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("get target %d: %w", targetID, err)
 	}
 
 	return result.Target, err
@@ -111,12 +111,12 @@ func (z *Client) UpdateTarget(ctx context.Context, targetID int64, field Target)
 	body, err := z.put(ctx, fmt.Sprintf("/targets/%d.json", targetID), data)
 
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("update target %d: %w", targetID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Target{}, err
+		return Target{}, fmt.Errorf("update target %d: %w", targetID, err)
 	}
 
 	return result.Target, err
@@ -128,7 +128,7 @@ func (z *Client) DeleteTarget(ctx context.Context, targetID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/targets/%d.json", targetID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete target %d: %w", targetID, err)
 	}
 
 	return nil
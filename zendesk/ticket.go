@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -75,11 +76,13 @@ type Ticket struct {
 
 	Via *Via `json:"via,omitempty"`
 
-	SatisfactionRating *struct {
-		ID      int64  `json:"id"`
-		Score   string `json:"score"`
-		Comment string `json:"comment"`
-	} `json:"satisfaction_rating,omitempty"`
+	// ViaID is POST only and sets the channel the ticket is reported to come from,
+	// e.g. ViaMail or ViaPhoneCallInbound, instead of the API. Zendesk accepts only
+	// some of the via types and Via itself is read-only; use tags to tell tickets of
+	// an integration apart from other API tickets. It is a pointer as ViaWebForm is 0.
+	ViaID *int `json:"via_id,omitempty"`
+
+	SatisfactionRating *SatisfactionRating `json:"satisfaction_rating,omitempty"`
 
 	SharingAgreementIDs []int64    `json:"sharing_agreement_ids,omitempty"`
 	FollowupIDs         []int64    `json:"followup_ids,omitempty"`
@@ -95,7 +98,12 @@ type Ticket struct {
 
 	SideConversation TicketSideConversation `json:"side_conversation,omitempty"`
 
-	// Collaborators is POST only
+	// CustomStatusID is the custom status of the ticket in accounts which use
+	// custom ticket statuses
+	CustomStatusID int64 `json:"custom_status_id,omitempty"`
+
+	// Collaborators is POST only. Unlike CollaboratorIDs, it accepts user IDs,
+	// emails or Collaborator name and email pairs, see Collaborators.Append
 	Collaborators *Collaborators `json:"collaborators,omitempty"`
 
 	// Comment is POST only and required
@@ -104,7 +112,88 @@ type Ticket struct {
 	// Requester is POST only and can be used to create a ticket for a nonexistent requester
 	Requester *Requester `json:"requester,omitempty"`
 
+	// AssigneeEmail is POST/PUT only and assigns the ticket to the agent with this email,
+	// without looking up the agent's user ID first. Don't set it together with AssigneeID.
+	AssigneeEmail string `json:"assignee_email,omitempty"`
+
+	// AdditionalTags is PUT only and adds tags without replacing the existing ones
+	AdditionalTags []string `json:"additional_tags,omitempty"`
+
+	// RemoveTags is PUT only and removes the given tags, keeping the others
+	RemoveTags []string `json:"remove_tags,omitempty"`
+
+	// EmailCCs and Followers are PUT only and add or remove single users,
+	// keeping the others. See TicketUpdate.
+	EmailCCs  []TicketUserChange `json:"email_ccs,omitempty"`
+	Followers []TicketUserChange `json:"followers,omitempty"`
+
+	// AdditionalCollaborators is PUT only and adds collaborators without replacing
+	// the existing ones. It takes the same values as Collaborators.
+	AdditionalCollaborators *Collaborators `json:"additional_collaborators,omitempty"`
+
 	// TODO: TicketAudit (POST only) #126
+
+	// Extra holds fields returned by Zendesk which are not mapped to Ticket yet.
+	// It is populated on decode and never sent back to the API.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes a ticket and keeps unknown fields in Extra
+func (t *Ticket) UnmarshalJSON(data []byte) error {
+	type ticket Ticket
+	var tmp ticket
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, tmp)
+	if err != nil {
+		return err
+	}
+
+	*t = Ticket(tmp)
+	t.Extra = extra
+	return nil
+}
+
+// ticketArchiveAge is how long after being closed a ticket gets archived
+const ticketArchiveAge = 120 * 24 * time.Hour
+
+// LikelyArchived guesses whether Zendesk has archived the ticket at now. Zendesk
+// archives tickets which have been closed for more than 120 days; archived tickets
+// are read-only and are excluded from ticket lists and views, but GetTicket still
+// returns them. The API doesn't report when a ticket was closed, so this is a
+// heuristic based on UpdatedAt, which is at or after the close time.
+func (t Ticket) LikelyArchived(now time.Time) bool {
+	return t.Status == string(TicketStatusClosed) && t.UpdatedAt != nil && now.Sub(*t.UpdatedAt) > ticketArchiveAge
+}
+
+// errDueAtNotTask is returned before sending a ticket whose due date the API would reject
+var errDueAtNotTask = errors.New("due_at can only be set for tickets of type task")
+
+// errFollowupNotClosed is returned before creating a followup of a ticket which is not closed
+var errFollowupNotClosed = errors.New("followups can only be created for closed tickets")
+
+// CustomField returns the value of the custom field with the ID, and whether the ticket has it
+func (t *Ticket) CustomField(id int64) (interface{}, bool) {
+	for _, cf := range t.CustomFields {
+		if cf.ID == id {
+			return cf.Value, true
+		}
+	}
+	return nil, false
+}
+
+// SetCustomField sets value to the custom field with the ID,
+// adding the field to CustomFields when the ticket doesn't have it yet
+func (t *Ticket) SetCustomField(id int64, value interface{}) {
+	for i := range t.CustomFields {
+		if t.CustomFields[i].ID == id {
+			t.CustomFields[i].Value = value
+			return
+		}
+	}
+	t.CustomFields = append(t.CustomFields, CustomField{ID: id, Value: value})
 }
 
 type TicketSideConversation struct {
@@ -123,13 +212,69 @@ type Requester struct {
 }
 
 // Via is information about source of Ticket or TicketComment
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/via-object-reference/
 type Via struct {
-	Channel string `json:"channel"`
-	Source  struct {
-		From map[string]interface{} `json:"from"`
-		To   map[string]interface{} `json:"to"`
-		Rel  string                 `json:"rel"`
-	} `json:"source"`
+	Channel string    `json:"channel"`
+	Source  ViaSource `json:"source"`
+}
+
+// ViaSource describes where a Ticket or TicketComment came from and went to
+type ViaSource struct {
+	From map[string]interface{} `json:"from"`
+	To   map[string]interface{} `json:"to"`
+	Rel  string                 `json:"rel"`
+}
+
+// Via channels which are commonly used to tell how a ticket was created
+const (
+	ViaChannelAPI              = "api"
+	ViaChannelChat             = "chat"
+	ViaChannelEmail            = "email"
+	ViaChannelRule             = "rule"
+	ViaChannelSideConversation = "side_conversation"
+	ViaChannelWeb              = "web"
+)
+
+// TicketPriority is priority of a ticket
+type TicketPriority string
+
+// Ticket priorities
+const (
+	TicketPriorityUrgent TicketPriority = "urgent"
+	TicketPriorityHigh   TicketPriority = "high"
+	TicketPriorityNormal TicketPriority = "normal"
+	TicketPriorityLow    TicketPriority = "low"
+)
+
+// TicketStatus is status of a ticket
+type TicketStatus string
+
+// Ticket statuses
+const (
+	TicketStatusNew     TicketStatus = "new"
+	TicketStatusOpen    TicketStatus = "open"
+	TicketStatusPending TicketStatus = "pending"
+	TicketStatusHold    TicketStatus = "hold"
+	TicketStatusSolved  TicketStatus = "solved"
+	TicketStatusClosed  TicketStatus = "closed"
+)
+
+// Actions of TicketUserChange
+const (
+	TicketUserChangePut    = "put"
+	TicketUserChangeDelete = "delete"
+)
+
+// TicketUserChange adds or removes one email CC or follower of a ticket.
+// The user is specified by UserID, or by UserEmail for email CCs.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-cc-s-and-followers/
+type TicketUserChange struct {
+	UserID    int64  `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	Action    string `json:"action"`
 }
 
 type TicketListOptions struct {
@@ -146,17 +291,149 @@ type TicketListOptions struct {
 // TicketAPI an interface containing all ticket related methods
 type TicketAPI interface {
 	GetTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetOrganizationTickets(ctx context.Context, organizationID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	CountOrganizationTickets(ctx context.Context, organizationID int64) (int, error)
+	SearchOrganizationTickets(ctx context.Context, organizationID int64, query string, opts *PageOptions) ([]Ticket, Page, error)
+	GetUserRequestedTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetUserCCDTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetUserAssignedTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetRecentTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error)
 	GetTicket(ctx context.Context, id int64) (Ticket, error)
 	GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error)
+	GetTicketByExternalID(ctx context.Context, externalID string) (Ticket, error)
+	CreateOrUpdateTicketByExternalID(ctx context.Context, ticket Ticket) (Ticket, error)
 	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
+	CreateFollowupTicket(ctx context.Context, closedTicketID int64, ticket Ticket) (Ticket, error)
 	UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error)
+	ClearTicketDueAt(ctx context.Context, ticketID int64) (Ticket, error)
+	SetTicketStatus(ctx context.Context, ticketID int64, status TicketStatus) (Ticket, error)
+	SetTicketPriority(ctx context.Context, ticketID int64, priority TicketPriority) (Ticket, error)
+	AssignTicket(ctx context.Context, ticketID, assigneeID int64) (Ticket, error)
+	UpdateManyTickets(ctx context.Context, ticketIDs []int64, ticket Ticket) (JobStatus, error)
+	CloseTickets(ctx context.Context, ticketIDs []int64, waitForJob bool) ([]JobStatus, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
+	GetIncrementalTickets(ctx context.Context, opts CursorOption) ([]Ticket, IncrementalCursor, error)
 }
 
 // GetTickets get ticket list
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
 func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, "/tickets.json", opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get tickets: %w", err)
+	}
+	return tickets, page, nil
+}
+
+// GetOrganizationTickets get ticket list of the specified organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, fmt.Sprintf("/organizations/%d/tickets.json", organizationID), opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get organization tickets %d: %w", organizationID, err)
+	}
+	return tickets, page, nil
+}
+
+// CountOrganizationTickets gets the number of tickets of the specified organization.
+// Zendesk caches the count for large organizations, so it may be a little behind.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#count-organization-tickets
+func (z *Client) CountOrganizationTickets(ctx context.Context, organizationID int64) (int, error) {
+	var data struct {
+		Count struct {
+			Value int `json:"value"`
+		} `json:"count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/tickets/count.json", organizationID))
+	if err != nil {
+		return 0, fmt.Errorf("count organization tickets %d: %w", organizationID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return 0, fmt.Errorf("count organization tickets %d: %w", organizationID, err)
+	}
+	return data.Count.Value, nil
+}
+
+// SearchOrganizationTickets searches tickets of the specified organization.
+// query takes additional search filters such as "status:open".
+// To count the matching tickets without fetching them, pass the same query to
+// SearchCount with "type:ticket organization:<id>" prepended.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/
+func (z *Client) SearchOrganizationTickets(ctx context.Context, organizationID int64, query string, opts *PageOptions) ([]Ticket, Page, error) {
+	searchOpts := &SearchOptions{
+		Query: strings.TrimSpace(fmt.Sprintf("type:ticket organization:%d %s", organizationID, query)),
+	}
+	if opts != nil {
+		searchOpts.PageOptions = *opts
+	}
+
+	results, page, err := z.Search(ctx, searchOpts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("search organization tickets %d: %w", organizationID, err)
+	}
+
+	var tickets []Ticket
+	for _, result := range results.List() {
+		if ticket, ok := result.(Ticket); ok {
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets, page, nil
+}
+
+// GetUserRequestedTickets get ticket list requested by the specified user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetUserRequestedTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, fmt.Sprintf("/users/%d/tickets/requested.json", userID), opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get user requested tickets %d: %w", userID, err)
+	}
+	return tickets, page, nil
+}
+
+// GetUserCCDTickets get ticket list on which the specified user is CC'd
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetUserCCDTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, fmt.Sprintf("/users/%d/tickets/ccd.json", userID), opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get user ccd tickets %d: %w", userID, err)
+	}
+	return tickets, page, nil
+}
+
+// GetUserAssignedTickets get ticket list assigned to the specified user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetUserAssignedTickets(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, fmt.Sprintf("/users/%d/tickets/assigned.json", userID), opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get user assigned tickets %d: %w", userID, err)
+	}
+	return tickets, page, nil
+}
+
+// GetRecentTickets get ticket list which the authenticated agent viewed recently
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetRecentTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getTicketList(ctx, "/tickets/recent.json", opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get recent tickets: %w", err)
+	}
+	return tickets, page, nil
+}
+
+// getTicketList gets tickets from one of the list tickets endpoints
+func (z *Client) getTicketList(ctx context.Context, path string, opts *TicketListOptions) ([]Ticket, Page, error) {
 	var data struct {
 		Tickets []Ticket `json:"tickets"`
 		Page
@@ -167,7 +444,7 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 		tmp = &TicketListOptions{}
 	}
 
-	u, err := addOptions("/tickets.json", tmp)
+	u, err := addOptions(path, tmp)
 	if err != nil {
 		return nil, Page{}, err
 	}
@@ -184,7 +461,8 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 	return data.Tickets, data.Page, nil
 }
 
-// GetTicket gets a specified ticket
+// GetTicket gets a specified ticket.
+// Archived tickets can be read with it too, see Ticket.LikelyArchived.
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#show-ticket
 func (z *Client) GetTicket(ctx context.Context, ticketID int64) (Ticket, error) {
@@ -194,12 +472,12 @@ func (z *Client) GetTicket(ctx context.Context, ticketID int64) (Ticket, error)
 
 	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d.json", ticketID))
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("get ticket %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("get ticket %d: %w", ticketID, err)
 	}
 
 	return result.Ticket, err
@@ -223,72 +501,336 @@ func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]T
 	req.IDs = strings.Join(idStrs, ",")
 
 	u, err := addOptions("/tickets/show_many.json", req)
+	if err != nil {
+		return nil, fmt.Errorf("get multiple tickets: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("get multiple tickets: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("get multiple tickets: %w", err)
+	}
+	return result.Tickets, nil
+}
+
+// GetTicketByExternalID gets the ticket which has the specified external ID.
+// An error is returned when no ticket or more than one ticket has the ID.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetTicketByExternalID(ctx context.Context, externalID string) (Ticket, error) {
+	tickets, err := z.getTicketsByExternalID(ctx, externalID)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("get ticket by external id %s: %w", externalID, err)
+	}
+
+	switch len(tickets) {
+	case 0:
+		return Ticket{}, fmt.Errorf("ticket with external id %s: %w", externalID, ErrNotFound)
+	case 1:
+		return tickets[0], nil
+	default:
+		return Ticket{}, fmt.Errorf("%d tickets have external id %s", len(tickets), externalID)
+	}
+}
+
+// getTicketsByExternalID gets the tickets which have the specified external ID.
+// Only the list tickets endpoint can filter by external_id, so it has its own options.
+func (z *Client) getTicketsByExternalID(ctx context.Context, externalID string) ([]Ticket, error) {
+	opts := struct {
+		ExternalID string `url:"external_id"`
+	}{externalID}
+
+	u, err := addOptions("/tickets.json", opts)
 	if err != nil {
 		return nil, err
 	}
 
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+	}
 	body, err := z.get(ctx, u)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = json.Unmarshal(body, &data)
 	if err != nil {
 		return nil, err
 	}
-	return result.Tickets, nil
+	return data.Tickets, nil
+}
+
+// CreateOrUpdateTicketByExternalID updates the ticket which has the same external ID
+// as ticket, or creates ticket when there is no such ticket yet.
+// ticket.ExternalID is required.
+func (z *Client) CreateOrUpdateTicketByExternalID(ctx context.Context, ticket Ticket) (Ticket, error) {
+	if ticket.ExternalID == "" {
+		return Ticket{}, fmt.Errorf("external id is required to create or update a ticket")
+	}
+
+	tickets, err := z.getTicketsByExternalID(ctx, ticket.ExternalID)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("create or update ticket by external id: %w", err)
+	}
+
+	switch len(tickets) {
+	case 0:
+		return z.CreateTicket(ctx, ticket)
+	case 1:
+		return z.UpdateTicket(ctx, tickets[0].ID, ticket)
+	default:
+		return Ticket{}, fmt.Errorf("%d tickets have external id %s", len(tickets), ticket.ExternalID)
+	}
 }
 
-// CreateTicket create a new ticket
+// CreateTicket create a new ticket.
+// DueAt can only be set when Type is TicketTypeTask.
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#create-ticket
 func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error) {
 	var data, result struct {
 		Ticket Ticket `json:"ticket"`
 	}
+	if ticket.DueAt != nil && ticket.Type != TicketTypeTask {
+		return Ticket{}, fmt.Errorf("create ticket: %w", errDueAtNotTask)
+	}
 	data.Ticket = ticket
 
 	body, err := z.post(ctx, "/tickets.json", data)
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("create ticket: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("create ticket: %w", err)
 	}
 	return result.Ticket, nil
 }
 
-// UpdateTicket update an existing ticket
+// CreateFollowupTicket creates a new ticket linked to a closed ticket.
+// Closed tickets can't be reopened, so a followup has to be created instead.
+// The source ticket is fetched first and an error is returned when it is not closed.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#creating-a-follow-up-ticket
+func (z *Client) CreateFollowupTicket(ctx context.Context, closedTicketID int64, ticket Ticket) (Ticket, error) {
+	source, err := z.GetTicket(ctx, closedTicketID)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("create followup ticket %d: %w", closedTicketID, err)
+	}
+
+	if source.Status != string(TicketStatusClosed) {
+		return Ticket{}, fmt.Errorf("create followup ticket %d: ticket is %s: %w", closedTicketID, source.Status, errFollowupNotClosed)
+	}
+
+	ticket.ViaFollowupSourceID = closedTicketID
+	followup, err := z.CreateTicket(ctx, ticket)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("create followup ticket %d: %w", closedTicketID, err)
+	}
+	return followup, nil
+}
+
+// UpdateTicket update an existing ticket.
+// When AdditionalTags or RemoveTags is set, Tags is not sent so that
+// the incremental change can't replace the whole tag list.
+// Likewise, CollaboratorIDs is not sent when AdditionalCollaborators is set.
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-ticket
 func (z *Client) UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error) {
 	var data, result struct {
 		Ticket Ticket `json:"ticket"`
 	}
+	if ticket.DueAt != nil && ticket.Type != "" && ticket.Type != TicketTypeTask {
+		return Ticket{}, fmt.Errorf("update ticket %d: %w", ticketID, errDueAtNotTask)
+	}
+	if len(ticket.AdditionalTags) > 0 || len(ticket.RemoveTags) > 0 {
+		ticket.Tags = nil
+	}
+	if ticket.AdditionalCollaborators != nil && len(ticket.AdditionalCollaborators.List()) > 0 {
+		ticket.CollaboratorIDs = nil
+	}
 	data.Ticket = ticket
 
 	path := fmt.Sprintf("/tickets/%d.json", ticketID)
 	body, err := z.put(ctx, path, data)
 	if err != nil {
-		return Ticket{}, err
+		return Ticket{}, fmt.Errorf("update ticket %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("update ticket %d: %w", ticketID, err)
+	}
+
+	return result.Ticket, nil
+}
+
+// ClearTicketDueAt removes the due date of a task ticket.
+// UpdateTicket can't do this because a nil DueAt is not sent.
+func (z *Client) ClearTicketDueAt(ctx context.Context, ticketID int64) (Ticket, error) {
+	ticket, err := z.updateTicketField(ctx, ticketID, "due_at", nil)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("clear ticket due at %d: %w", ticketID, err)
+	}
+	return ticket, nil
+}
+
+// SetTicketStatus changes only the status of the ticket, e.g. TicketStatusSolved
+func (z *Client) SetTicketStatus(ctx context.Context, ticketID int64, status TicketStatus) (Ticket, error) {
+	ticket, err := z.updateTicketField(ctx, ticketID, "status", status)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("set ticket status %d: %w", ticketID, err)
+	}
+	return ticket, nil
+}
+
+// SetTicketPriority changes only the priority of the ticket
+func (z *Client) SetTicketPriority(ctx context.Context, ticketID int64, priority TicketPriority) (Ticket, error) {
+	ticket, err := z.updateTicketField(ctx, ticketID, "priority", priority)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("set ticket priority %d: %w", ticketID, err)
+	}
+	return ticket, nil
+}
+
+// AssignTicket changes only the assignee of the ticket
+func (z *Client) AssignTicket(ctx context.Context, ticketID, assigneeID int64) (Ticket, error) {
+	ticket, err := z.updateTicketField(ctx, ticketID, "assignee_id", assigneeID)
+	if err != nil {
+		return Ticket{}, fmt.Errorf("assign ticket %d: %w", ticketID, err)
+	}
+	return ticket, nil
+}
+
+// updateTicketField sends an update of a single field, so that concurrent
+// changes of the other fields are not overwritten
+func (z *Client) updateTicketField(ctx context.Context, ticketID int64, field string, value interface{}) (Ticket, error) {
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	data := map[string]interface{}{
+		"ticket": map[string]interface{}{field: value},
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
 	if err != nil {
 		return Ticket{}, err
 	}
 
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
 	return result.Ticket, nil
 }
 
+// UpdateManyTickets applies the same update to all of the specified tickets.
+// Zendesk runs the update as a background job, whose status is returned.
+// At most 100 tickets can be updated at once.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) UpdateManyTickets(ctx context.Context, ticketIDs []int64, ticket Ticket) (JobStatus, error) {
+	var data struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+	if ticket.DueAt != nil && ticket.Type != "" && ticket.Type != TicketTypeTask {
+		return JobStatus{}, fmt.Errorf("update many tickets: %w", errDueAtNotTask)
+	}
+	if len(ticket.AdditionalTags) > 0 || len(ticket.RemoveTags) > 0 {
+		ticket.Tags = nil
+	}
+	if ticket.AdditionalCollaborators != nil && len(ticket.AdditionalCollaborators.List()) > 0 {
+		ticket.CollaboratorIDs = nil
+	}
+	data.Ticket = ticket
+
+	var req struct {
+		IDs string `url:"ids,omitempty"`
+	}
+	idStrs := make([]string, len(ticketIDs))
+	for i := 0; i < len(ticketIDs); i++ {
+		idStrs[i] = strconv.FormatInt(ticketIDs[i], 10)
+	}
+	req.IDs = strings.Join(idStrs, ",")
+
+	u, err := addOptions("/tickets/update_many.json", req)
+	if err != nil {
+		return JobStatus{}, fmt.Errorf("update many tickets: %w", err)
+	}
+
+	body, err := z.put(ctx, u, data)
+	if err != nil {
+		return JobStatus{}, fmt.Errorf("update many tickets: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, fmt.Errorf("update many tickets: %w", err)
+	}
+
+	return result.JobStatus, nil
+}
+
+// updateManyBatchSize is the maximum number of tickets update_many accepts
+const updateManyBatchSize = 100
+
+// CloseTickets sets the status of the specified tickets to closed.
+// Zendesk only closes tickets which are solved: the others fail in the job results
+// and are left unchanged, so set them to TicketStatusSolved first.
+// The tickets are updated in batches of 100 and a job status is returned for each batch.
+// When waitForJob is true, each job is polled until it is done before the next batch
+// is sent, and the result for each ticket is in JobStatus.Results.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) CloseTickets(ctx context.Context, ticketIDs []int64, waitForJob bool) ([]JobStatus, error) {
+	jobs, err := z.updateManyTicketsInBatches(ctx, ticketIDs, Ticket{Status: string(TicketStatusClosed)}, waitForJob)
+	if err != nil {
+		return jobs, fmt.Errorf("close tickets: %w", err)
+	}
+	return jobs, nil
+}
+
+// updateManyTicketsInBatches calls UpdateManyTickets for every 100 tickets,
+// waiting for each job to be done when wait is true
+func (z *Client) updateManyTicketsInBatches(ctx context.Context, ticketIDs []int64, ticket Ticket, wait bool) ([]JobStatus, error) {
+	var jobs []JobStatus
+	for start := 0; start < len(ticketIDs); start += updateManyBatchSize {
+		end := start + updateManyBatchSize
+		if end > len(ticketIDs) {
+			end = len(ticketIDs)
+		}
+
+		job, err := z.UpdateManyTickets(ctx, ticketIDs[start:end], ticket)
+		if err != nil {
+			return jobs, err
+		}
+
+		if wait {
+			job, err = z.waitJobStatus(ctx, job)
+			if err != nil {
+				return append(jobs, job), err
+			}
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
 // DeleteTicket deletes the specified ticket
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#delete-ticket
 func (z *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/tickets/%d.json", ticketID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete ticket %d: %w", ticketID, err)
 	}
 
 	return nil
@@ -24,7 +24,10 @@ type TicketAuditVia struct {
 	Source  struct {
 		To   interface{} `json:"to,omitempty"`
 		From interface{} `json:"from,omitempty"`
-		Ref  string      `json:"ref,omitempty"`
+		Rel  string      `json:"rel,omitempty"`
+
+		// Deprecated: Zendesk sends this value as "rel". Use Rel instead.
+		Ref string `json:"ref,omitempty"`
 	} `json:"source,omitempty"`
 }
 
@@ -45,17 +48,17 @@ func (z *Client) GetAllTicketAudits(ctx context.Context, opts CursorOption) ([]T
 
 	u, err := addOptions("/ticket_audits.json", opts)
 	if err != nil {
-		return []TicketAudit{}, Cursor{}, err
+		return []TicketAudit{}, Cursor{}, fmt.Errorf("get all ticket audits: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []TicketAudit{}, Cursor{}, err
+		return []TicketAudit{}, Cursor{}, fmt.Errorf("get all ticket audits: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return []TicketAudit{}, Cursor{}, err
+		return []TicketAudit{}, Cursor{}, fmt.Errorf("get all ticket audits: %w", err)
 	}
 
 	return result.Audits, result.Cursor, err
@@ -71,17 +74,17 @@ func (z *Client) GetTicketAudits(ctx context.Context, ticketID int64, opts PageO
 
 	u, err := addOptions(fmt.Sprintf("/tickets/%d/audits.json", ticketID), opts)
 	if err != nil {
-		return []TicketAudit{}, Page{}, err
+		return []TicketAudit{}, Page{}, fmt.Errorf("get ticket audits %d: %w", ticketID, err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []TicketAudit{}, Page{}, err
+		return []TicketAudit{}, Page{}, fmt.Errorf("get ticket audits %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return []TicketAudit{}, Page{}, err
+		return []TicketAudit{}, Page{}, fmt.Errorf("get ticket audits %d: %w", ticketID, err)
 	}
 
 	return result.Audits, result.Page, err
@@ -96,12 +99,12 @@ func (z *Client) GetTicketAudit(ctx context.Context, ticketID, ID int64) (Ticket
 
 	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/audits/%d.json", ticketID, ID))
 	if err != nil {
-		return TicketAudit{}, err
+		return TicketAudit{}, fmt.Errorf("get ticket audit %d of ticket %d: %w", ID, ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketAudit{}, err
+		return TicketAudit{}, fmt.Errorf("get ticket audit %d of ticket %d: %w", ID, ticketID, err)
 	}
 
 	return result.Audit, err
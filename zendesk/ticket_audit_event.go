@@ -0,0 +1,181 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Types of ticket audit events
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_audits/#audit-events
+const (
+	AuditEventTypeCreate       = "Create"
+	AuditEventTypeChange       = "Change"
+	AuditEventTypeComment      = "Comment"
+	AuditEventTypeVoiceComment = "VoiceComment"
+	AuditEventTypeNotification = "Notification"
+)
+
+// AuditEvent is one of the events of a ticket audit.
+// Use Type to tell which of the As methods decodes it.
+type AuditEvent struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON keeps the raw event so it can be decoded into a typed event later
+func (e *AuditEvent) UnmarshalJSON(data []byte) error {
+	type alias AuditEvent
+	var tmp alias
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*e = AuditEvent(tmp)
+	e.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON returns the raw event
+func (e AuditEvent) MarshalJSON() ([]byte, error) {
+	if e.raw == nil {
+		type alias AuditEvent
+		return json.Marshal(alias(e))
+	}
+	return e.raw, nil
+}
+
+// Decode decodes the event into v
+func (e AuditEvent) Decode(v interface{}) error {
+	return json.Unmarshal(e.raw, v)
+}
+
+// CommentEvent is an event of type Comment
+type CommentEvent struct {
+	ID          int64          `json:"id"`
+	Type        string         `json:"type"`
+	AuthorID    int64          `json:"author_id"`
+	Body        string         `json:"body"`
+	HTMLBody    string         `json:"html_body"`
+	PlainBody   string         `json:"plain_body"`
+	Public      bool           `json:"public"`
+	Attachments []Attachment   `json:"attachments"`
+	AuditID     int64          `json:"audit_id"`
+	Via         TicketAuditVia `json:"via"`
+}
+
+// ChangeEvent is an event of type Change. Value and PreviousValue are
+// strings for most fields, and arrays of strings for fields such as tags.
+type ChangeEvent struct {
+	ID            int64          `json:"id"`
+	Type          string         `json:"type"`
+	FieldName     string         `json:"field_name"`
+	Value         interface{}    `json:"value"`
+	PreviousValue interface{}    `json:"previous_value"`
+	Via           TicketAuditVia `json:"via"`
+}
+
+// NotificationEvent is an event of type Notification
+type NotificationEvent struct {
+	ID         int64          `json:"id"`
+	Type       string         `json:"type"`
+	Subject    string         `json:"subject"`
+	Body       string         `json:"body"`
+	Recipients []int64        `json:"recipients"`
+	Via        TicketAuditVia `json:"via"`
+}
+
+// VoiceCommentEvent is an event of type VoiceComment
+type VoiceCommentEvent struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	AuthorID int64  `json:"author_id"`
+	Body     string `json:"body"`
+	HTMLBody string `json:"html_body"`
+	Public   bool   `json:"public"`
+	Data     struct {
+		From              string `json:"from"`
+		To                string `json:"to"`
+		RecordingURL      string `json:"recording_url"`
+		CallDuration      int    `json:"call_duration"`
+		AnsweredByID      int64  `json:"answered_by_id"`
+		TranscriptionText string `json:"transcription_text"`
+	} `json:"data"`
+	FormattedFrom        string `json:"formatted_from"`
+	FormattedTo          string `json:"formatted_to"`
+	TranscriptionVisible bool   `json:"transcription_visible"`
+}
+
+// AsComment decodes the event as a CommentEvent
+func (e AuditEvent) AsComment() (CommentEvent, error) {
+	var event CommentEvent
+	err := e.decodeAs(AuditEventTypeComment, &event)
+	return event, err
+}
+
+// AsChange decodes the event as a ChangeEvent
+func (e AuditEvent) AsChange() (ChangeEvent, error) {
+	var event ChangeEvent
+	err := e.decodeAs(AuditEventTypeChange, &event)
+	return event, err
+}
+
+// AsNotification decodes the event as a NotificationEvent
+func (e AuditEvent) AsNotification() (NotificationEvent, error) {
+	var event NotificationEvent
+	err := e.decodeAs(AuditEventTypeNotification, &event)
+	return event, err
+}
+
+// AsVoiceComment decodes the event as a VoiceCommentEvent
+func (e AuditEvent) AsVoiceComment() (VoiceCommentEvent, error) {
+	var event VoiceCommentEvent
+	err := e.decodeAs(AuditEventTypeVoiceComment, &event)
+	return event, err
+}
+
+func (e AuditEvent) decodeAs(eventType string, v interface{}) error {
+	if e.Type != eventType {
+		return fmt.Errorf("audit event %d is %s, not %s", e.ID, e.Type, eventType)
+	}
+	return e.Decode(v)
+}
+
+// AuditEvents returns the events of the audit as AuditEvent
+func (a TicketAudit) AuditEvents() ([]AuditEvent, error) {
+	events := make([]AuditEvent, 0, len(a.Events))
+	for _, raw := range a.Events {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		var event AuditEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// FilterAuditEvents returns the events of the specified type from all audits.
+// Events which can't be decoded are skipped.
+func FilterAuditEvents(audits []TicketAudit, eventType string) []AuditEvent {
+	var events []AuditEvent
+	for _, audit := range audits {
+		all, err := audit.AuditEvents()
+		if err != nil {
+			continue
+		}
+
+		for _, event := range all {
+			if event.Type == eventType {
+				events = append(events, event)
+			}
+		}
+	}
+	return events
+}
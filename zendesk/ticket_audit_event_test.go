@@ -0,0 +1,66 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestFilterAuditEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audits.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audits, _, err := client.GetTicketAudits(ctx, 666, PageOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get ticket audits: %s", err)
+	}
+
+	comments := FilterAuditEvents(audits, AuditEventTypeComment)
+	if len(comments) != 1 {
+		t.Fatalf("expected length of comment events is 1, but got %d", len(comments))
+	}
+
+	comment, err := comments[0].AsComment()
+	if err != nil {
+		t.Fatalf("Failed to decode comment event: %s", err)
+	}
+
+	if comment.ID != 2127301148 || comment.Public || comment.Body != "This is a new private comment" {
+		t.Fatalf("Unexpected comment event %v", comment)
+	}
+
+	changes := FilterAuditEvents(audits, AuditEventTypeChange)
+	if len(changes) != 1 {
+		t.Fatalf("expected length of change events is 1, but got %d", len(changes))
+	}
+
+	change, err := changes[0].AsChange()
+	if err != nil {
+		t.Fatalf("Failed to decode change event: %s", err)
+	}
+
+	if change.FieldName != "status" || change.Value != "open" || change.PreviousValue != "new" {
+		t.Fatalf("Unexpected change event %v", change)
+	}
+
+	if change.Via.Source.Rel != "trigger" {
+		t.Fatalf("Unexpected via of change event %v", change.Via)
+	}
+}
+
+func TestAuditEventWrongType(t *testing.T) {
+	audit := TicketAudit{
+		Events: []interface{}{
+			map[string]interface{}{"id": 1, "type": AuditEventTypeChange, "field_name": "status"},
+		},
+	}
+
+	events, err := audit.AuditEvents()
+	if err != nil {
+		t.Fatalf("Failed to get audit events: %s", err)
+	}
+
+	if _, err := events[0].AsComment(); err == nil {
+		t.Fatal("expected an error when decoding a change event as a comment")
+	}
+}
@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 )
@@ -50,3 +51,19 @@ func TestGetTicketAudit(t *testing.T) {
 		t.Fatalf("Returned ticket audit does not have the expected ID %d. Ticket audit id is %d", expectedID, ticketAudit.ID)
 	}
 }
+
+func TestTicketAuditViaSourceRel(t *testing.T) {
+	var audit TicketAudit
+	err := json.Unmarshal([]byte(`{"via": {"channel": "rule", "source": {"rel": "trigger"}}}`), &audit)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ticket audit: %s", err)
+	}
+
+	if audit.Via.Channel != ViaChannelRule {
+		t.Fatalf("expected via channel %s, but got %s", ViaChannelRule, audit.Via.Channel)
+	}
+
+	if audit.Via.Source.Rel != "trigger" {
+		t.Fatalf("expected via source rel trigger, but got %s", audit.Via.Source.Rel)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"time"
 )
 
@@ -11,6 +12,16 @@ import (
 type TicketCommentAPI interface {
 	CreateTicketComment(ctx context.Context, ticketID int64, ticketComment TicketComment) (TicketComment, error)
 	ListTicketComments(ctx context.Context, ticketID int64) ([]TicketComment, error)
+	RedactTicketComment(ctx context.Context, ticketID, commentID int64, text string) (TicketComment, error)
+	RedactTicketPatterns(ctx context.Context, ticketID int64, patterns []*regexp.Regexp) ([]RedactedComment, error)
+	PreviewRedactTicketPatterns(ctx context.Context, ticketID int64, patterns []*regexp.Regexp) ([]RedactedComment, error)
+}
+
+// RedactedComment reports the strings which RedactTicketPatterns redacted from a comment,
+// or which PreviewRedactTicketPatterns would redact
+type RedactedComment struct {
+	CommentID int64
+	Redacted  []string
 }
 
 // TicketComment is a struct for ticket comment payload
@@ -69,13 +80,13 @@ func (z *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticket
 
 	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
 	if err != nil {
-		return TicketComment{}, err
+		return TicketComment{}, fmt.Errorf("create ticket comment %d: %w", ticketID, err)
 	}
 
 	result := TicketComment{}
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketComment{}, err
+		return TicketComment{}, fmt.Errorf("create ticket comment %d: %w", ticketID, err)
 	}
 
 	return result, err
@@ -91,13 +102,138 @@ func (z *Client) ListTicketComments(ctx context.Context, ticketID int64) ([]Tick
 
 	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/comments.json", ticketID))
 	if err != nil {
-		return []TicketComment{}, err
+		return []TicketComment{}, fmt.Errorf("list ticket comments %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return []TicketComment{}, err
+		return []TicketComment{}, fmt.Errorf("list ticket comments %d: %w", ticketID, err)
 	}
 
 	return result.TicketComments, err
 }
+
+// RedactTicketComment permanently removes text from the body of a comment.
+// Each occurrence of text is replaced with a redaction marker.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_comments/#redact-string-in-comment
+func (z *Client) RedactTicketComment(ctx context.Context, ticketID, commentID int64, text string) (TicketComment, error) {
+	var data struct {
+		Text string `json:"text"`
+	}
+	data.Text = text
+
+	var result struct {
+		Comment TicketComment `json:"comment"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d/comments/%d/redact.json", ticketID, commentID), data)
+	if err != nil {
+		return TicketComment{}, fmt.Errorf("redact ticket comment %d of ticket %d: %w", commentID, ticketID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketComment{}, fmt.Errorf("redact ticket comment %d of ticket %d: %w", commentID, ticketID, err)
+	}
+	return result.Comment, nil
+}
+
+// listAllTicketComments gets the comments of every page of a ticket.
+// ListTicketComments returns only the first page.
+func (z *Client) listAllTicketComments(ctx context.Context, ticketID int64) ([]TicketComment, error) {
+	opts := PageOptions{PerPage: maxPerPage, Page: 1}
+
+	var comments []TicketComment
+	for {
+		var data struct {
+			TicketComments []TicketComment `json:"comments"`
+			Page
+		}
+
+		u, err := addOptions(fmt.Sprintf("/tickets/%d/comments.json", ticketID), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, data.TicketComments...)
+
+		if !data.Page.HasNext() {
+			return comments, nil
+		}
+		opts.Page++
+	}
+}
+
+// RedactTicketPatterns redacts every string matching one of the patterns from all comments
+// of a ticket, e.g. credit card numbers. It returns what was redacted from each comment
+// which had a match. When a redaction fails, the comments redacted so far are returned with the error.
+func (z *Client) RedactTicketPatterns(ctx context.Context, ticketID int64, patterns []*regexp.Regexp) ([]RedactedComment, error) {
+	comments, err := z.listAllTicketComments(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("redact ticket patterns %d: %w", ticketID, err)
+	}
+
+	var report []RedactedComment
+	for _, comment := range comments {
+		matches := matchPatterns(comment.Body, patterns)
+		if len(matches) == 0 {
+			continue
+		}
+
+		redacted := RedactedComment{CommentID: comment.ID}
+		for _, text := range matches {
+			if _, err := z.RedactTicketComment(ctx, ticketID, comment.ID, text); err != nil {
+				if len(redacted.Redacted) > 0 {
+					report = append(report, redacted)
+				}
+				return report, fmt.Errorf("redact ticket patterns %d: %w", ticketID, err)
+			}
+			redacted.Redacted = append(redacted.Redacted, text)
+		}
+		report = append(report, redacted)
+	}
+	return report, nil
+}
+
+// PreviewRedactTicketPatterns returns what RedactTicketPatterns would redact from each
+// comment of a ticket which has a match, without redacting anything.
+func (z *Client) PreviewRedactTicketPatterns(ctx context.Context, ticketID int64, patterns []*regexp.Regexp) ([]RedactedComment, error) {
+	comments, err := z.listAllTicketComments(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("preview redact ticket patterns %d: %w", ticketID, err)
+	}
+
+	var report []RedactedComment
+	for _, comment := range comments {
+		if matches := matchPatterns(comment.Body, patterns); len(matches) > 0 {
+			report = append(report, RedactedComment{CommentID: comment.ID, Redacted: matches})
+		}
+	}
+	return report, nil
+}
+
+// matchPatterns returns the distinct strings in body which match any of the patterns
+func matchPatterns(body string, patterns []*regexp.Regexp) []string {
+	var matches []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		for _, match := range pattern.FindAllString(body, -1) {
+			if match == "" || seen[match] {
+				continue
+			}
+			seen[match] = true
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
@@ -1,7 +1,12 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -51,3 +56,145 @@ func TestListTicketComments(t *testing.T) {
 		t.Fatalf("Returned ticket comments does not have the expected length %d. Ticket comments length is %d", expectedLength, len(ticketComments))
 	}
 }
+
+func TestListTicketCommentsAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketComments, err := client.ListTicketComments(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to list ticket comments: %s", err)
+	}
+
+	attachments := ticketComments[1].Attachments
+	if len(attachments) != 1 {
+		t.Fatalf("expected length of attachments is 1, but got %d", len(attachments))
+	}
+
+	attachment := attachments[0]
+	if attachment.FileName != "screenshot.png" || !attachment.Inline || attachment.Size != 24517 {
+		t.Fatalf("unexpected attachment %+v", attachment)
+	}
+	if len(attachment.Thumbnails) != 1 || attachment.Thumbnails[0].ContentURL != "https://example.zendesk.com/attachments/token/def/?name=screenshot_thumb.png" {
+		t.Fatalf("unexpected thumbnails %+v", attachment.Thumbnails)
+	}
+}
+
+func TestRedactTicketPatterns(t *testing.T) {
+	var redacted []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tickets/2/comments.json":
+			w.Write([]byte(`{"comments": [
+				{"id": 10, "body": "My card is 4111 1111 1111 1111, the old one was 4111 1111 1111 1111 too"},
+				{"id": 11, "body": "Thanks, we will charge it"},
+				{"id": 12, "body": "Use 5500-0000-0000-0004 instead"}
+			]}`))
+		case r.Method == http.MethodPut:
+			var data struct {
+				Text string `json:"text"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Errorf("Failed to decode request body: %s", err)
+				return
+			}
+			redacted = append(redacted, r.URL.Path+" "+data.Text)
+			w.Write([]byte(`{"comment": {"id": 10}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cardNumber := regexp.MustCompile(`\b(?:\d{4}[ -]?){3}\d{4}\b`)
+	report, err := client.RedactTicketPatterns(ctx, 2, []*regexp.Regexp{cardNumber})
+	if err != nil {
+		t.Fatalf("Failed to redact ticket patterns: %s", err)
+	}
+
+	expectedReport := []RedactedComment{
+		{CommentID: 10, Redacted: []string{"4111 1111 1111 1111"}},
+		{CommentID: 12, Redacted: []string{"5500-0000-0000-0004"}},
+	}
+	if !reflect.DeepEqual(report, expectedReport) {
+		t.Fatalf("expected report %v, but got %v", expectedReport, report)
+	}
+
+	expectedRequests := []string{
+		"/tickets/2/comments/10/redact.json 4111 1111 1111 1111",
+		"/tickets/2/comments/12/redact.json 5500-0000-0000-0004",
+	}
+	if !reflect.DeepEqual(redacted, expectedRequests) {
+		t.Fatalf("expected redactions %v, but got %v", expectedRequests, redacted)
+	}
+}
+
+func TestRedactTicketPatternsAllPages(t *testing.T) {
+	var redacted []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tickets/2/comments.json":
+			page := r.URL.Query().Get("page")
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_comments_page"+page+".json")))
+		case r.Method == http.MethodPut:
+			var data struct {
+				Text string `json:"text"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Errorf("Failed to decode request body: %s", err)
+			}
+			redacted = append(redacted, r.URL.Path+" "+data.Text)
+			w.Write([]byte(`{"comment": {"id": 10}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cardNumber := regexp.MustCompile(`\b(?:\d{4}[ -]?){3}\d{4}\b`)
+	report, err := client.RedactTicketPatterns(ctx, 2, []*regexp.Regexp{cardNumber})
+	if err != nil {
+		t.Fatalf("Failed to redact ticket patterns: %s", err)
+	}
+
+	expectedRequests := []string{
+		"/tickets/2/comments/10/redact.json 4111 1111 1111 1111",
+		"/tickets/2/comments/12/redact.json 5500-0000-0000-0004",
+	}
+	if !reflect.DeepEqual(redacted, expectedRequests) {
+		t.Fatalf("expected the comment of the second page to be redacted too, but got %v", redacted)
+	}
+	if len(report) != 2 || report[1].CommentID != 12 {
+		t.Fatalf("unexpected report %v", report)
+	}
+}
+
+func TestPreviewRedactTicketPatterns(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/tickets/2/comments.json" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_comments_page"+page+".json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cardNumber := regexp.MustCompile(`\b(?:\d{4}[ -]?){3}\d{4}\b`)
+	report, err := client.PreviewRedactTicketPatterns(ctx, 2, []*regexp.Regexp{cardNumber})
+	if err != nil {
+		t.Fatalf("Failed to preview ticket patterns: %s", err)
+	}
+
+	expected := []RedactedComment{
+		{CommentID: 10, Redacted: []string{"4111 1111 1111 1111"}},
+		{CommentID: 12, Redacted: []string{"5500-0000-0000-0004"}},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("unexpected report %v", report)
+	}
+}
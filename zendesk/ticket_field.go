@@ -53,6 +53,7 @@ type TicketFieldAPI interface {
 	GetTicketField(ctx context.Context, ticketID int64) (TicketField, error)
 	UpdateTicketField(ctx context.Context, ticketID int64, field TicketField) (TicketField, error)
 	DeleteTicketField(ctx context.Context, ticketID int64) error
+	SetCustomFieldByTitle(ctx context.Context, ticket *Ticket, title string, value interface{}) error
 }
 
 // GetTicketFields fetches ticket field list
@@ -63,14 +64,14 @@ func (z *Client) GetTicketFields(ctx context.Context) ([]TicketField, Page, erro
 		Page
 	}
 
-	body, err := z.get(ctx, "/ticket_fields.json")
+	body, err := z.getCached(ctx, "/ticket_fields.json")
 	if err != nil {
-		return []TicketField{}, Page{}, err
+		return []TicketField{}, Page{}, fmt.Errorf("get ticket fields: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []TicketField{}, Page{}, err
+		return []TicketField{}, Page{}, fmt.Errorf("get ticket fields: %w", err)
 	}
 	return data.TicketFields, data.Page, nil
 }
@@ -85,12 +86,12 @@ func (z *Client) CreateTicketField(ctx context.Context, ticketField TicketField)
 
 	body, err := z.post(ctx, "/ticket_fields.json", data)
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("create ticket field: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("create ticket field: %w", err)
 	}
 	return result.TicketField, nil
 }
@@ -102,15 +103,15 @@ func (z *Client) GetTicketField(ctx context.Context, ticketID int64) (TicketFiel
 		TicketField TicketField `json:"ticket_field"`
 	}
 
-	body, err := z.get(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID))
+	body, err := z.getCached(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID))
 
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("get ticket field %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("get ticket field %d: %w", ticketID, err)
 	}
 
 	return result.TicketField, err
@@ -128,12 +129,12 @@ func (z *Client) UpdateTicketField(ctx context.Context, ticketID int64, field Ti
 	body, err := z.put(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID), data)
 
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("update ticket field %d: %w", ticketID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketField{}, err
+		return TicketField{}, fmt.Errorf("update ticket field %d: %w", ticketID, err)
 	}
 
 	return result.TicketField, err
@@ -145,8 +146,64 @@ func (z *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID))
 
 	if err != nil {
-		return err
+		return fmt.Errorf("delete ticket field %d: %w", ticketID, err)
 	}
 
 	return nil
 }
+
+// SetCustomFieldByTitle sets value to the custom field of ticket whose ticket field has title.
+// IDs of ticket fields differ between accounts, so this lets the same code work with any account.
+// The IDs are looked up in the ticket fields, which are fetched once and remembered by
+// the client along with the titles not found in them. Creating, updating or deleting a
+// ticket field through the client or InvalidateCache makes the client fetch them again.
+// With SetCacheTTL, the ticket fields are fetched through its cache.
+func (z *Client) SetCustomFieldByTitle(ctx context.Context, ticket *Ticket, title string, value interface{}) error {
+	fieldID, err := z.ticketFieldIDByTitle(ctx, title)
+	if err != nil {
+		return fmt.Errorf("set custom field %q: %w", title, err)
+	}
+
+	ticket.SetCustomField(fieldID, value)
+	return nil
+}
+
+// ticketFieldIDByTitle looks up the ID of the ticket field with title, fetching the
+// ticket fields when title is neither known nor a remembered miss, which has ID 0
+func (z *Client) ticketFieldIDByTitle(ctx context.Context, title string) (int64, error) {
+	z.ticketFieldMu.Lock()
+	id, ok := z.ticketFieldIDs[title]
+	z.ticketFieldMu.Unlock()
+
+	if !ok {
+		fields, _, err := z.GetTicketFields(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		ids := make(map[string]int64, len(fields)+1)
+		for _, field := range fields {
+			ids[field.Title] = field.ID
+		}
+		if _, ok := ids[title]; !ok {
+			ids[title] = 0
+		}
+		id = ids[title]
+
+		z.ticketFieldMu.Lock()
+		z.ticketFieldIDs = ids
+		z.ticketFieldMu.Unlock()
+	}
+
+	if id == 0 {
+		return 0, fmt.Errorf("ticket field with title %q: %w", title, ErrNotFound)
+	}
+	return id, nil
+}
+
+// forgetTicketFieldIDs makes ticketFieldIDByTitle fetch the ticket fields again
+func (z *Client) forgetTicketFieldIDs() {
+	z.ticketFieldMu.Lock()
+	z.ticketFieldIDs = nil
+	z.ticketFieldMu.Unlock()
+}
@@ -1,8 +1,10 @@
 package zendesk
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +78,58 @@ func TestDeleteTicketField(t *testing.T) {
 		t.Fatalf("Failed to delete ticket field: %s", err)
 	}
 }
+
+func TestSetCustomFieldByTitle(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_fields.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket := Ticket{CustomFields: []CustomField{{ID: 360011737454, Value: "old"}}}
+	if err := client.SetCustomFieldByTitle(ctx, &ticket, "Subject", "new subject"); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+	if err := client.SetCustomFieldByTitle(ctx, &ticket, "Description", "new description"); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+
+	expected := []CustomField{
+		{ID: 360011737454, Value: "new description"},
+		{ID: 360011737434, Value: "new subject"},
+	}
+	if len(ticket.CustomFields) != 2 || ticket.CustomFields[0] != expected[0] || ticket.CustomFields[1] != expected[1] {
+		t.Fatalf("Unexpected custom fields %v", ticket.CustomFields)
+	}
+
+	if requests != 1 {
+		t.Fatalf("Ticket fields should be cached. requests: %d", requests)
+	}
+
+	err := client.SetCustomFieldByTitle(ctx, &ticket, "No such field", "value")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound for unknown title: %v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("Ticket fields should be fetched again for unknown title. requests: %d", requests)
+	}
+
+	err = client.SetCustomFieldByTitle(ctx, &ticket, "No such field", "value")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound for unknown title: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("Unknown title should be remembered. requests: %d", requests)
+	}
+
+	client.InvalidateCache()
+	if err := client.SetCustomFieldByTitle(ctx, &ticket, "Subject", "new subject"); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+	if requests != 3 {
+		t.Fatalf("Ticket fields should be fetched again after InvalidateCache. requests: %d", requests)
+	}
+}
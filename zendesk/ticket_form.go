@@ -58,17 +58,17 @@ func (z *Client) GetTicketForms(ctx context.Context, options *TicketFormListOpti
 
 	u, err := addOptions("/ticket_forms.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get ticket forms: %w", err)
 	}
 
-	body, err := z.get(ctx, u)
+	body, err := z.getCached(ctx, u)
 	if err != nil {
-		return []TicketForm{}, Page{}, err
+		return []TicketForm{}, Page{}, fmt.Errorf("get ticket forms: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []TicketForm{}, Page{}, err
+		return []TicketForm{}, Page{}, fmt.Errorf("get ticket forms: %w", err)
 	}
 	return data.TicketForms, data.Page, nil
 }
@@ -83,12 +83,12 @@ func (z *Client) CreateTicketForm(ctx context.Context, ticketForm TicketForm) (T
 
 	body, err := z.post(ctx, "/ticket_forms.json", data)
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("create ticket form: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("create ticket form: %w", err)
 	}
 	return result.TicketForm, nil
 }
@@ -100,14 +100,14 @@ func (z *Client) GetTicketForm(ctx context.Context, id int64) (TicketForm, error
 		TicketForm TicketForm `json:"ticket_form"`
 	}
 
-	body, err := z.get(ctx, fmt.Sprintf("/ticket_forms/%d.json", id))
+	body, err := z.getCached(ctx, fmt.Sprintf("/ticket_forms/%d.json", id))
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("get ticket form %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("get ticket form %d: %w", id, err)
 	}
 	return result.TicketForm, nil
 }
@@ -122,12 +122,12 @@ func (z *Client) UpdateTicketForm(ctx context.Context, id int64, form TicketForm
 	data.TicketForm = form
 	body, err := z.put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), data)
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("update ticket form %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return TicketForm{}, err
+		return TicketForm{}, fmt.Errorf("update ticket form %d: %w", id, err)
 	}
 
 	return result.TicketForm, nil
@@ -138,7 +138,7 @@ func (z *Client) UpdateTicketForm(ctx context.Context, id int64, form TicketForm
 func (z *Client) DeleteTicketForm(ctx context.Context, id int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/ticket_forms/%d.json", id))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete ticket form %d: %w", id, err)
 	}
 
 	return nil
@@ -0,0 +1,129 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MetricDuration is a ticket metric measured both in calendar time and in business hours.
+// Zendesk reports it in minutes, which are kept in CalendarMinutes and BusinessMinutes.
+type MetricDuration struct {
+	Calendar time.Duration `json:"-"`
+	Business time.Duration `json:"-"`
+
+	CalendarMinutes int `json:"calendar"`
+	BusinessMinutes int `json:"business"`
+}
+
+// UnmarshalJSON decodes the minutes and converts them to durations.
+// Metrics which are not measured yet are null and decoded as 0.
+func (d *MetricDuration) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		Calendar *int `json:"calendar"`
+		Business *int `json:"business"`
+	}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*d = MetricDuration{}
+	if tmp.Calendar != nil {
+		d.CalendarMinutes = *tmp.Calendar
+		d.Calendar = time.Duration(*tmp.Calendar) * time.Minute
+	}
+	if tmp.Business != nil {
+		d.BusinessMinutes = *tmp.Business
+		d.Business = time.Duration(*tmp.Business) * time.Minute
+	}
+	return nil
+}
+
+// TicketMetric is the metrics of a ticket, such as how long it took to reply and to solve it
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metrics/
+type TicketMetric struct {
+	ID       int64  `json:"id"`
+	TicketID int64  `json:"ticket_id"`
+	URL      string `json:"url"`
+
+	GroupStations    int `json:"group_stations"`
+	AssigneeStations int `json:"assignee_stations"`
+	Reopens          int `json:"reopens"`
+	Replies          int `json:"replies"`
+
+	ReplyTime           MetricDuration `json:"reply_time_in_minutes"`
+	FirstResolutionTime MetricDuration `json:"first_resolution_time_in_minutes"`
+	FullResolutionTime  MetricDuration `json:"full_resolution_time_in_minutes"`
+	AgentWaitTime       MetricDuration `json:"agent_wait_time_in_minutes"`
+	RequesterWaitTime   MetricDuration `json:"requester_wait_time_in_minutes"`
+	OnHoldTime          MetricDuration `json:"on_hold_time_in_minutes"`
+
+	AssigneeUpdatedAt    *time.Time `json:"assignee_updated_at"`
+	RequesterUpdatedAt   *time.Time `json:"requester_updated_at"`
+	StatusUpdatedAt      *time.Time `json:"status_updated_at"`
+	InitiallyAssignedAt  *time.Time `json:"initially_assigned_at"`
+	AssignedAt           *time.Time `json:"assigned_at"`
+	SolvedAt             *time.Time `json:"solved_at"`
+	LatestCommentAddedAt *time.Time `json:"latest_comment_added_at"`
+	CreatedAt            *time.Time `json:"created_at"`
+	UpdatedAt            *time.Time `json:"updated_at"`
+}
+
+// TicketMetricAPI an interface containing all ticket metric related methods
+type TicketMetricAPI interface {
+	GetTicketMetrics(ctx context.Context, opts *PageOptions) ([]TicketMetric, Page, error)
+	GetTicketMetric(ctx context.Context, ticketID int64) (TicketMetric, error)
+}
+
+// GetTicketMetrics gets the metrics of all tickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metrics/#list-ticket-metrics
+func (z *Client) GetTicketMetrics(ctx context.Context, opts *PageOptions) ([]TicketMetric, Page, error) {
+	var data struct {
+		TicketMetrics []TicketMetric `json:"ticket_metrics"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/ticket_metrics.json", tmp)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get ticket metrics: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get ticket metrics: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get ticket metrics: %w", err)
+	}
+	return data.TicketMetrics, data.Page, nil
+}
+
+// GetTicketMetric gets the metrics of the specified ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metrics/#show-ticket-metrics
+func (z *Client) GetTicketMetric(ctx context.Context, ticketID int64) (TicketMetric, error) {
+	var data struct {
+		TicketMetric TicketMetric `json:"ticket_metric"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/metrics.json", ticketID))
+	if err != nil {
+		return TicketMetric{}, fmt.Errorf("get ticket metric %d: %w", ticketID, err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return TicketMetric{}, fmt.Errorf("get ticket metric %d: %w", ticketID, err)
+	}
+	return data.TicketMetric, nil
+}
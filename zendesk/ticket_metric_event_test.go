@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetIncrementalTicketMetricEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metric_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, page, err := client.GetIncrementalTicketMetricEvents(ctx, &TicketMetricEventListOptions{StartTime: 1603716792})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric events: %s", err)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("expected length of ticket metric events is 3, but got %d", len(events))
+	}
+	if !page.EndOfStream || page.EndTime != 1603720392 {
+		t.Fatalf("unexpected page %+v", page)
+	}
+
+	sla := events[1].SLA
+	if events[1].Type != MetricEventTypeApplySLA || sla == nil || sla.Target != 60 || sla.Policy.ID != 360000245593 {
+		t.Fatalf("unexpected apply_sla event %+v", events[1])
+	}
+	if events[0].SLA != nil {
+		t.Fatalf("expected no SLA on a measure event")
+	}
+}
+
+func TestGetTicketMetricEvents(t *testing.T) {
+	var startTimes []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTimes = append(startTimes, r.URL.Query().Get("start_time"))
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_metric_events.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, err := client.GetTicketMetricEvents(ctx, 155, &TicketMetricEventListOptions{StartTime: 1603716792})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric events: %s", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected length of ticket metric events is 2, but got %d", len(events))
+	}
+	if len(startTimes) != 1 || startTimes[0] != "1603716792" {
+		t.Fatalf("unexpected requests with start times %v", startTimes)
+	}
+}
+
+func TestGetTicketMetricEventsWithoutStartTime(t *testing.T) {
+	var requests int
+	mockAPI := newCountingMockAPI(&requests)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetTicketMetricEvents(ctx, 155, nil)
+	if !errors.Is(err, errNoMetricEventStartTime) {
+		t.Fatalf("expected errNoMetricEventStartTime, but got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("expected no requests, but got %d", requests)
+	}
+}
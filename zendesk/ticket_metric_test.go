@@ -0,0 +1,49 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetTicketMetrics(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metrics.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	metrics, _, err := client.GetTicketMetrics(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get ticket metrics: %s", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected length of ticket metrics is 1, but got %d", len(metrics))
+	}
+}
+
+func TestGetTicketMetric(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metric.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	metric, err := client.GetTicketMetric(ctx, 4343)
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric: %s", err)
+	}
+
+	if metric.TicketID != 4343 {
+		t.Fatalf("Unexpected ticket metric %v", metric)
+	}
+
+	if metric.ReplyTime.CalendarMinutes != 2391 || metric.ReplyTime.Calendar != 2391*time.Minute {
+		t.Fatalf("Unexpected calendar reply time %v", metric.ReplyTime)
+	}
+
+	if metric.ReplyTime.BusinessMinutes != 737 || metric.ReplyTime.Business != 737*time.Minute {
+		t.Fatalf("Unexpected business reply time %v", metric.ReplyTime)
+	}
+
+	if metric.OnHoldTime != (MetricDuration{}) {
+		t.Fatalf("expected null on hold time to be zero, but got %v", metric.OnHoldTime)
+	}
+}
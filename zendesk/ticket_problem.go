@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Ticket types which can be set to Ticket.Type
+const (
+	TicketTypeQuestion = "question"
+	TicketTypeIncident = "incident"
+	TicketTypeProblem  = "problem"
+	TicketTypeTask     = "task"
+)
+
+// TicketProblemAPI an interface containing problem and incident ticket related methods
+type TicketProblemAPI interface {
+	GetTicketIncidents(ctx context.Context, problemID int64, opts *PageOptions) ([]Ticket, Page, error)
+	GetTicketProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error)
+	AutocompleteProblems(ctx context.Context, name string) ([]Ticket, error)
+}
+
+// GetTicketIncidents gets incident tickets linked to the specified problem ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#list-problem-incidents
+func (z *Client) GetTicketIncidents(ctx context.Context, problemID int64, opts *PageOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getProblemList(ctx, fmt.Sprintf("/tickets/%d/incidents.json", problemID), opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get ticket incidents %d: %w", problemID, err)
+	}
+	return tickets, page, nil
+}
+
+// GetTicketProblems gets problem tickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#list-ticket-problems
+func (z *Client) GetTicketProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error) {
+	tickets, page, err := z.getProblemList(ctx, "/problems.json", opts)
+	if err != nil {
+		return nil, Page{}, fmt.Errorf("get ticket problems: %w", err)
+	}
+	return tickets, page, nil
+}
+
+// AutocompleteProblems gets problem tickets whose subject matches the specified name
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#autocomplete-problems
+func (z *Client) AutocompleteProblems(ctx context.Context, name string) ([]Ticket, error) {
+	var data struct {
+		Text string `json:"text"`
+	}
+	data.Text = name
+
+	var result struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+
+	body, err := z.post(ctx, "/problems/autocomplete.json", data)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete problems: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete problems: %w", err)
+	}
+	return result.Tickets, nil
+}
+
+// getProblemList gets tickets from one of the problem list endpoints
+func (z *Client) getProblemList(ctx context.Context, path string, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
@@ -0,0 +1,74 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTicketIncidents(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTicketIncidents(ctx, 33, nil)
+	if err != nil {
+		t.Fatalf("Failed to get incidents: %s", err)
+	}
+
+	if path != "/tickets/33/incidents.json" {
+		t.Fatalf("Unexpected path %s", path)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("Returned tickets does not have the expected length 2. Tickets length is %d", len(tickets))
+	}
+}
+
+func TestGetTicketProblems(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTicketProblems(ctx, &PageOptions{PerPage: 10})
+	if err != nil {
+		t.Fatalf("Failed to get problems: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("Returned tickets does not have the expected length 2. Tickets length is %d", len(tickets))
+	}
+}
+
+func TestAutocompleteProblems(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/problems/autocomplete.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var data struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil || data.Text != "print" {
+			t.Errorf("expected text print, but got %q (%v)", data.Text, err)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.AutocompleteProblems(ctx, "print")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete problems: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("Returned tickets does not have the expected length 2. Tickets length is %d", len(tickets))
+	}
+}
@@ -3,12 +3,17 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetTickets(t *testing.T) {
@@ -34,6 +39,90 @@ func TestGetTickets(t *testing.T) {
 	}
 }
 
+func TestGetScopedTickets(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cases := []struct {
+		expectedPath string
+		list         func() ([]Ticket, Page, error)
+	}{
+		{"/organizations/1/tickets.json", func() ([]Ticket, Page, error) {
+			return client.GetOrganizationTickets(ctx, 1, nil)
+		}},
+		{"/users/2/tickets/requested.json", func() ([]Ticket, Page, error) {
+			return client.GetUserRequestedTickets(ctx, 2, nil)
+		}},
+		{"/users/2/tickets/ccd.json", func() ([]Ticket, Page, error) {
+			return client.GetUserCCDTickets(ctx, 2, nil)
+		}},
+		{"/users/2/tickets/assigned.json", func() ([]Ticket, Page, error) {
+			return client.GetUserAssignedTickets(ctx, 2, &TicketListOptions{SortBy: "id"})
+		}},
+		{"/tickets/recent.json", func() ([]Ticket, Page, error) {
+			return client.GetRecentTickets(ctx, nil)
+		}},
+	}
+
+	for _, c := range cases {
+		tickets, _, err := c.list()
+		if err != nil {
+			t.Fatalf("Failed to get tickets from %s: %s", c.expectedPath, err)
+		}
+
+		if path != c.expectedPath {
+			t.Fatalf("expected path %s, but got %s", c.expectedPath, path)
+		}
+
+		if len(tickets) != 2 {
+			t.Fatalf("Returned tickets does not have the expected length 2. Tickets length is %d", len(tickets))
+		}
+	}
+}
+
+func TestCountOrganizationTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_tickets_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.CountOrganizationTickets(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to count organization tickets: %s", err)
+	}
+
+	if count != 102 {
+		t.Fatalf("expected count 102, but got %d", count)
+	}
+}
+
+func TestSearchOrganizationTickets(t *testing.T) {
+	var query string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.SearchOrganizationTickets(ctx, 360363695492, "status:open", nil)
+	if err != nil {
+		t.Fatalf("Failed to search organization tickets: %s", err)
+	}
+
+	if query != "type:ticket organization:360363695492 status:open" {
+		t.Fatalf("Unexpected query %s", query)
+	}
+
+	if len(tickets) != 1 {
+		t.Fatalf("Returned tickets does not have the expected length 1. Tickets length is %d", len(tickets))
+	}
+}
+
 func TestGetTicket(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
 	client := newTestClient(mockAPI)
@@ -50,12 +139,8 @@ func TestGetTicket(t *testing.T) {
 	}
 
 	expectedVia := &Via{
-		Channel: "email",
-		Source: struct {
-			From map[string]interface{} `json:"from"`
-			To   map[string]interface{} `json:"to"`
-			Rel  string                 `json:"rel"`
-		}{
+		Channel: ViaChannelEmail,
+		Source: ViaSource{
 			From: map[string]interface{}{
 				"address": "nukosuke@lavabit.com",
 				"name":    "Yosuke Tamura",
@@ -73,6 +158,25 @@ func TestGetTicket(t *testing.T) {
 	}
 }
 
+func TestGetTicketKeepsUnknownFields(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.GetTicket(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if _, ok := ticket.Extra["satisfaction_probability"]; !ok {
+		t.Fatalf("Expected satisfaction_probability in unknown fields but got %v", ticket.Extra)
+	}
+
+	if _, ok := ticket.Extra["subject"]; ok {
+		t.Fatal("Known field subject should not be in unknown fields")
+	}
+}
+
 func TestGetTicketCanceledContext(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
 	client := newTestClient(mockAPI)
@@ -169,6 +273,79 @@ func TestGetMultipleTicket(t *testing.T) {
 	}
 }
 
+func TestGetTicketByExternalID(t *testing.T) {
+	var externalID string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		externalID = r.URL.Query().Get("external_id")
+		w.Write([]byte(`{"tickets": [{"id": 2, "external_id": "ext-2"}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.GetTicketByExternalID(ctx, "ext-2")
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if externalID != "ext-2" {
+		t.Fatalf("expected external_id query ext-2, but got %s", externalID)
+	}
+
+	if ticket.ID != 2 {
+		t.Fatalf("Returned ticket does not have the expected ID 2. Ticket id is %d", ticket.ID)
+	}
+}
+
+func TestGetTicketByExternalIDNotFound(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"tickets": []}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetTicketByExternalID(ctx, "ext-2"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound when no ticket was found, but got %v", err)
+	}
+}
+
+func TestCreateOrUpdateTicketByExternalID(t *testing.T) {
+	cases := []struct {
+		list           string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{`{"tickets": []}`, http.MethodPost, "/tickets.json"},
+		{`{"tickets": [{"id": 2, "external_id": "ext-2"}]}`, http.MethodPut, "/tickets/2.json"},
+	}
+
+	for _, c := range cases {
+		var method, path string
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.Write([]byte(c.list))
+				return
+			}
+
+			method, path = r.Method, r.URL.Path
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+			}
+			w.Write([]byte(`{"ticket": {"id": 2, "external_id": "ext-2"}}`))
+		}))
+		client := newTestClient(mockAPI)
+
+		_, err := client.CreateOrUpdateTicketByExternalID(ctx, Ticket{ExternalID: "ext-2", Subject: "upsert"})
+		mockAPI.Close()
+		if err != nil {
+			t.Fatalf("Failed to create or update ticket: %s", err)
+		}
+
+		if method != c.expectedMethod || path != c.expectedPath {
+			t.Fatalf("expected %s %s, but got %s %s", c.expectedMethod, c.expectedPath, method, path)
+		}
+	}
+}
+
 func TestCreateTicket(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPost, "ticket.json", http.StatusCreated)
 	client := newTestClient(mockAPI)
@@ -190,6 +367,258 @@ func TestCreateTicket(t *testing.T) {
 	}
 }
 
+func TestCreateFollowupTicket(t *testing.T) {
+	var followupSourceID float64
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"ticket": {"id": 2, "status": "closed"}}`))
+		case http.MethodPost:
+			var payload map[string]map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			followupSourceID, _ = payload["ticket"]["via_followup_source_id"].(float64)
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.CreateFollowupTicket(ctx, 2, Ticket{Subject: "followup"})
+	if err != nil {
+		t.Fatalf("Failed to create followup ticket: %s", err)
+	}
+
+	if followupSourceID != 2 {
+		t.Fatalf("expected via_followup_source_id 2, but got %v", followupSourceID)
+	}
+
+	expectedID := int64(4)
+	if ticket.ID != expectedID {
+		t.Fatalf("Returned ticket does not have the expected ID %d. Ticket id is %d", expectedID, ticket.ID)
+	}
+}
+
+func TestCreateFollowupTicketNotClosed(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateFollowupTicket(ctx, 2, Ticket{Subject: "followup"})
+	if !errors.Is(err, errFollowupNotClosed) {
+		t.Fatalf("expected errFollowupNotClosed for a ticket which is not closed, but got %v", err)
+	}
+}
+
+func TestCreateTicketWithCollaborators(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var collaborators Collaborators
+	collaborators.Append(int64(562))
+	collaborators.Append("someone@example.com")
+	collaborators.Append(Collaborator{Name: "Someone Else", Email: "else@example.com"})
+
+	_, err := client.CreateTicket(ctx, Ticket{
+		CollaboratorIDs: []int64{35436},
+		FollowerIDs:     []int64{35437},
+		Collaborators:   &collaborators,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	ticket := payload["ticket"]
+	if !reflect.DeepEqual(ticket["collaborator_ids"], []interface{}{float64(35436)}) {
+		t.Fatalf("Unexpected collaborator_ids %v", ticket["collaborator_ids"])
+	}
+
+	if !reflect.DeepEqual(ticket["follower_ids"], []interface{}{float64(35437)}) {
+		t.Fatalf("Unexpected follower_ids %v", ticket["follower_ids"])
+	}
+
+	expected := []interface{}{
+		float64(562),
+		"someone@example.com",
+		map[string]interface{}{"name": "Someone Else", "email": "else@example.com"},
+	}
+	if !reflect.DeepEqual(ticket["collaborators"], expected) {
+		t.Fatalf("Unexpected collaborators %v", ticket["collaborators"])
+	}
+}
+
+func TestCreateTicketWithUploads(t *testing.T) {
+	var payload struct {
+		Ticket struct {
+			Comment map[string]interface{} `json:"comment"`
+		} `json:"ticket"`
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicket(ctx, Ticket{
+		Subject: "Invoice",
+		Comment: &TicketComment{
+			Body:    "See the attached invoice",
+			Uploads: []string{"6bk3gql82em5nmf"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	comment := payload.Ticket.Comment
+	if !reflect.DeepEqual(comment["uploads"], []interface{}{"6bk3gql82em5nmf"}) {
+		t.Fatalf("Unexpected comment %v", comment)
+	}
+}
+
+func TestCreateTicketWithRequester(t *testing.T) {
+	var payload struct {
+		Ticket struct {
+			Requester map[string]interface{} `json:"requester"`
+		} `json:"ticket"`
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicket(ctx, Ticket{
+		Subject:   "Help",
+		Requester: &Requester{Name: "New Customer", Email: "new@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	expected := map[string]interface{}{"name": "New Customer", "email": "new@example.com"}
+	if !reflect.DeepEqual(payload.Ticket.Requester, expected) {
+		t.Fatalf("expected requester %v, but got %v", expected, payload.Ticket.Requester)
+	}
+}
+
+func TestCreateTicketWithRouting(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicket(ctx, Ticket{
+		Recipient:    "support@example.com",
+		GroupID:      360004077472,
+		BrandID:      360002256672,
+		TicketFormID: 360000389592,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"recipient":      "support@example.com",
+		"group_id":       float64(360004077472),
+		"brand_id":       float64(360002256672),
+		"ticket_form_id": float64(360000389592),
+	}
+	for key, value := range expected {
+		if payload["ticket"][key] != value {
+			t.Fatalf("Unexpected %s %v", key, payload["ticket"][key])
+		}
+	}
+}
+
+func TestCreateTicketWithViaID(t *testing.T) {
+	var payloads []map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		payloads = append(payloads, payload)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	webForm, phone := ViaWebForm, ViaPhoneCallInbound
+	for _, ticket := range []Ticket{{ViaID: &webForm}, {ViaID: &phone}, {}} {
+		if _, err := client.CreateTicket(ctx, ticket); err != nil {
+			t.Fatalf("Failed to create ticket: %s", err)
+		}
+	}
+
+	if via, ok := payloads[0]["ticket"]["via_id"]; !ok || via != float64(ViaWebForm) {
+		t.Fatalf("expected via_id of web form to be sent, but got %v", payloads[0])
+	}
+	if via := payloads[1]["ticket"]["via_id"]; via != float64(ViaPhoneCallInbound) {
+		t.Fatalf("Unexpected via_id %v", via)
+	}
+	if _, ok := payloads[2]["ticket"]["via_id"]; ok {
+		t.Fatalf("expected via_id to be omitted, but got %v", payloads[2])
+	}
+}
+
+func TestUpdateTicketOmitsEmptyCollaborators(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{Subject: "subject"})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	for _, key := range []string{"collaborator_ids", "follower_ids", "collaborators"} {
+		if _, ok := payload["ticket"][key]; ok {
+			t.Fatalf("%s should be omitted when empty: %v", key, payload["ticket"])
+		}
+	}
+}
+
 func TestUpdateTicket(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPut, "ticket.json", http.StatusOK)
 	client := newTestClient(mockAPI)
@@ -206,6 +635,173 @@ func TestUpdateTicket(t *testing.T) {
 	}
 }
 
+func TestUpdateTicketWithIncrementalTags(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{
+		Tags:           []string{"replace"},
+		AdditionalTags: []string{"add"},
+		RemoveTags:     []string{"remove"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	ticket := payload["ticket"]
+	if _, ok := ticket["tags"]; ok {
+		t.Fatalf("tags should not be sent with incremental tag changes: %v", ticket)
+	}
+
+	if !reflect.DeepEqual(ticket["additional_tags"], []interface{}{"add"}) {
+		t.Fatalf("Unexpected additional_tags %v", ticket["additional_tags"])
+	}
+
+	if !reflect.DeepEqual(ticket["remove_tags"], []interface{}{"remove"}) {
+		t.Fatalf("Unexpected remove_tags %v", ticket["remove_tags"])
+	}
+}
+
+func TestUpdateTicketWithAssigneeEmail(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{AssigneeEmail: "agent@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	ticket := payload["ticket"]
+	if ticket["assignee_email"] != "agent@example.com" {
+		t.Fatalf("Unexpected assignee_email %v", ticket["assignee_email"])
+	}
+
+	if _, ok := ticket["assignee_id"]; ok {
+		t.Fatalf("assignee_id should not be sent: %v", ticket)
+	}
+}
+
+func TestUpdateTicketWithAdditionalCollaborators(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var additional Collaborators
+	additional.Append(int64(562))
+	additional.Append("someone@example.com")
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{
+		CollaboratorIDs:         []int64{35334},
+		AdditionalCollaborators: &additional,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	ticket := payload["ticket"]
+	if _, ok := ticket["collaborator_ids"]; ok {
+		t.Fatalf("collaborator_ids should not be sent with additional collaborators: %v", ticket)
+	}
+
+	expected := []interface{}{float64(562), "someone@example.com"}
+	if !reflect.DeepEqual(ticket["additional_collaborators"], expected) {
+		t.Fatalf("Unexpected additional_collaborators %v", ticket["additional_collaborators"])
+	}
+}
+
+func TestUpdateManyTickets(t *testing.T) {
+	var ids string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = r.URL.Query().Get("ids")
+		w.Write(readFixture(filepath.Join(http.MethodPut, "update_many.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.UpdateManyTickets(ctx, []int64{4, 5}, Ticket{AdditionalTags: []string{"bulk"}})
+	if err != nil {
+		t.Fatalf("Failed to update many tickets: %s", err)
+	}
+
+	if ids != "4,5" {
+		t.Fatalf("Unexpected ids %s", ids)
+	}
+
+	if job.Status != JobStatusQueued {
+		t.Fatalf("Returned job does not have the expected status. status: %s", job.Status)
+	}
+}
+
+func TestCloseTickets(t *testing.T) {
+	var batches []int
+	polled := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			polled++
+			w.Write(readFixture(filepath.Join(http.MethodGet, "job_status.json")))
+			return
+		}
+
+		var payload map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		if payload["ticket"]["status"] != "closed" {
+			t.Errorf("Unexpected payload %v", payload)
+			return
+		}
+		batches = append(batches, len(strings.Split(r.URL.Query().Get("ids"), ",")))
+		w.Write(readFixture(filepath.Join(http.MethodPut, "update_many.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	interval := jobPollInterval
+	jobPollInterval = 0
+	defer func() { jobPollInterval = interval }()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	jobs, err := client.CloseTickets(ctx, ids, true)
+	if err != nil {
+		t.Fatalf("Failed to close tickets: %s", err)
+	}
+
+	if !reflect.DeepEqual(batches, []int{100, 50}) {
+		t.Fatalf("Tickets should be updated in batches of 100. batches: %v", batches)
+	}
+
+	if len(jobs) != 2 || polled != 2 || !jobs[0].Done() || len(jobs[1].Results) == 0 {
+		t.Fatalf("Jobs should have been waited for. jobs: %v, polled: %d", jobs, polled)
+	}
+}
+
 func TestUpdateTicketFailure(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPut, "ticket.json", http.StatusInternalServerError)
 	client := newTestClient(mockAPI)
@@ -248,3 +844,157 @@ func TestTicketMarshalling(t *testing.T) {
 	}
 
 }
+
+func TestTicketLikelyArchived(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := `{"id": 1, "status": "closed", "updated_at": "2019-06-05T01:13:24Z"}`
+	recent := `{"id": 2, "status": "closed", "updated_at": "2019-12-20T01:13:24Z"}`
+	open := `{"id": 3, "status": "open", "updated_at": "2019-06-05T01:13:24Z"}`
+
+	cases := map[string]bool{old: true, recent: false, open: false}
+	for data, expected := range cases {
+		var ticket Ticket
+		if err := json.Unmarshal([]byte(data), &ticket); err != nil {
+			t.Fatalf("Failed to unmarshal ticket: %s", err)
+		}
+
+		if ticket.LikelyArchived(now) != expected {
+			t.Fatalf("Ticket %d should have LikelyArchived %t", ticket.ID, expected)
+		}
+	}
+}
+
+func TestTicketCustomField(t *testing.T) {
+	ticket := Ticket{CustomFields: []CustomField{{ID: 1, Value: "one"}}}
+
+	if v, ok := ticket.CustomField(1); !ok || v != "one" {
+		t.Fatalf("expected custom field 1 to be one, but got %v", v)
+	}
+
+	if _, ok := ticket.CustomField(2); ok {
+		t.Fatal("custom field 2 should not be found")
+	}
+
+	ticket.SetCustomField(1, "uno")
+	ticket.SetCustomField(2, []string{"two"})
+
+	expected := []CustomField{{ID: 1, Value: "uno"}, {ID: 2, Value: []string{"two"}}}
+	if !reflect.DeepEqual(ticket.CustomFields, expected) {
+		t.Fatalf("expected custom fields %v, but got %v", expected, ticket.CustomFields)
+	}
+}
+
+func TestCreateTaskTicketWithDueAt(t *testing.T) {
+	var payload struct {
+		Ticket struct {
+			Type  string `json:"type"`
+			DueAt string `json:"due_at"`
+		} `json:"ticket"`
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	due := time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)
+	_, err := client.CreateTicket(ctx, Ticket{Subject: "Ship the order", Type: TicketTypeTask, DueAt: &due})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	if payload.Ticket.Type != TicketTypeTask || payload.Ticket.DueAt != "2021-03-01T09:00:00Z" {
+		t.Fatalf("Unexpected payload %v", payload)
+	}
+}
+
+func TestDueAtRequiresTaskTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s", r.URL)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	due := time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "Help", DueAt: &due}); err == nil {
+		t.Fatal("expected an error when creating a ticket with due_at which is not a task")
+	}
+
+	if _, err := client.UpdateTicket(ctx, 2, Ticket{Type: TicketTypeQuestion, DueAt: &due}); err == nil {
+		t.Fatal("expected an error when changing a ticket with due_at to a question")
+	}
+}
+
+func TestClearTicketDueAt(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.ClearTicketDueAt(ctx, 2); err != nil {
+		t.Fatalf("Failed to clear due at: %s", err)
+	}
+
+	expected := `{"ticket":{"due_at":null}}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
+
+func TestUpdateSingleTicketField(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/2.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tests := []struct {
+		name     string
+		update   func() (Ticket, error)
+		expected string
+	}{
+		{
+			name:     "status",
+			update:   func() (Ticket, error) { return client.SetTicketStatus(ctx, 2, TicketStatusSolved) },
+			expected: `{"ticket":{"status":"solved"}}`,
+		},
+		{
+			name:     "priority",
+			update:   func() (Ticket, error) { return client.SetTicketPriority(ctx, 2, TicketPriorityUrgent) },
+			expected: `{"ticket":{"priority":"urgent"}}`,
+		},
+		{
+			name:     "assignee",
+			update:   func() (Ticket, error) { return client.AssignTicket(ctx, 2, 377922500012) },
+			expected: `{"ticket":{"assignee_id":377922500012}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		ticket, err := tt.update()
+		if err != nil {
+			t.Fatalf("Failed to update %s: %s", tt.name, err)
+		}
+		if ticket.ID == 0 {
+			t.Fatalf("expected the updated ticket for %s", tt.name)
+		}
+		if payload != tt.expected {
+			t.Fatalf("expected payload %s, but got %s", tt.expected, payload)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package zendesk
+
+// TicketUpdate builds a ticket for UpdateTicket which changes only what its methods set.
+// Email CCs and followers are added and removed one by one, so the other CCs and
+// followers of the ticket are kept.
+//
+//	update := zendesk.NewTicketUpdate().
+//		AddCC("customer@example.com").
+//		AddFollower(agentID).
+//		SetStatus(zendesk.TicketStatusPending).
+//		AddComment("We are looking into it", true)
+//	ticket, err := client.UpdateTicket(ctx, ticketID, update.Ticket())
+type TicketUpdate struct {
+	ticket Ticket
+}
+
+// NewTicketUpdate returns an empty TicketUpdate
+func NewTicketUpdate() *TicketUpdate {
+	return &TicketUpdate{}
+}
+
+// AddCC adds the user with email as an email CC. Zendesk creates the user if needed.
+func (u *TicketUpdate) AddCC(email string) *TicketUpdate {
+	u.ticket.EmailCCs = append(u.ticket.EmailCCs, TicketUserChange{UserEmail: email, Action: TicketUserChangePut})
+	return u
+}
+
+// RemoveCC removes the user from the email CCs
+func (u *TicketUpdate) RemoveCC(userID int64) *TicketUpdate {
+	u.ticket.EmailCCs = append(u.ticket.EmailCCs, TicketUserChange{UserID: userID, Action: TicketUserChangeDelete})
+	return u
+}
+
+// AddFollower adds the agent as a follower
+func (u *TicketUpdate) AddFollower(userID int64) *TicketUpdate {
+	u.ticket.Followers = append(u.ticket.Followers, TicketUserChange{UserID: userID, Action: TicketUserChangePut})
+	return u
+}
+
+// RemoveFollower removes the agent from the followers
+func (u *TicketUpdate) RemoveFollower(userID int64) *TicketUpdate {
+	u.ticket.Followers = append(u.ticket.Followers, TicketUserChange{UserID: userID, Action: TicketUserChangeDelete})
+	return u
+}
+
+// SetStatus sets the status, e.g. TicketStatusSolved
+func (u *TicketUpdate) SetStatus(status TicketStatus) *TicketUpdate {
+	u.ticket.Status = string(status)
+	return u
+}
+
+// AddTags adds tags, keeping the existing ones
+func (u *TicketUpdate) AddTags(tags ...string) *TicketUpdate {
+	u.ticket.AdditionalTags = append(u.ticket.AdditionalTags, tags...)
+	return u
+}
+
+// AddComment adds a public comment or a private note. Zendesk adds a single comment
+// per update, so AddComment panics when the update already has a comment rather
+// than dropping one of them.
+func (u *TicketUpdate) AddComment(body string, public bool) *TicketUpdate {
+	if u.ticket.Comment != nil {
+		panic("zendesk: TicketUpdate already has a comment")
+	}
+	u.ticket.Comment = &TicketComment{Body: body, Public: &public}
+	return u
+}
+
+// Ticket returns the ticket to pass to UpdateTicket
+func (u *TicketUpdate) Ticket() Ticket {
+	return u.ticket
+}
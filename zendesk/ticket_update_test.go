@@ -0,0 +1,62 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTicketUpdate(t *testing.T) {
+	update := NewTicketUpdate().
+		AddCC("customer@example.com").
+		RemoveCC(12).
+		AddFollower(34).
+		SetStatus(TicketStatusPending).
+		AddTags("escalated").
+		AddComment("We are looking into it", true)
+
+	data, err := json.Marshal(update.Ticket())
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket update: %s", err)
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("Failed to unmarshal ticket update: %s", err)
+	}
+
+	expected := map[string]string{
+		"status":          `"pending"`,
+		"additional_tags": `["escalated"]`,
+		"email_ccs":       `[{"user_email":"customer@example.com","action":"put"},{"user_id":12,"action":"delete"}]`,
+		"followers":       `[{"user_id":34,"action":"put"}]`,
+	}
+	for key, value := range expected {
+		if string(payload[key]) != value {
+			t.Fatalf("expected %s to be %s, but got %s", key, value, payload[key])
+		}
+	}
+
+	for _, key := range []string{"tags", "collaborator_ids", "follower_ids", "email_cc_ids"} {
+		if _, ok := payload[key]; ok {
+			t.Fatalf("%s should not be sent by a ticket update", key)
+		}
+	}
+
+	var comment TicketComment
+	json.Unmarshal(payload["comment"], &comment)
+	if comment.Body != "We are looking into it" || comment.Public == nil || !*comment.Public {
+		t.Fatalf("Unexpected comment %v", comment)
+	}
+}
+
+func TestTicketUpdateSecondComment(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a second comment to panic")
+		}
+	}()
+
+	NewTicketUpdate().
+		AddComment("Looking", true).
+		AddComment("We are looking into it", true)
+}
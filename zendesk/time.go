@@ -0,0 +1,62 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// zendeskTimeLayouts are the timestamp formats returned by Zendesk. Most endpoints
+// return RFC3339, but older records and some endpoints have no offset or use
+// the format of Zendesk's Ruby backend. Times without an offset are in UTC.
+// Zone abbreviations other than UTC are rejected rather than parsed with the
+// "MST" layout, which reads any zone Go doesn't know as UTC.
+var zendeskTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 UTC",
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05 -0700",
+	"2006-01-02",
+}
+
+// ZendeskTime is a time.Time which accepts any timestamp format returned by Zendesk.
+// It's encoded as RFC3339 like time.Time.
+type ZendeskTime struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes a timestamp in any of the formats returned by Zendesk.
+// null and an empty string are decoded as the zero time.
+func (t *ZendeskTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = ZendeskTime{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = ZendeskTime{}
+		return nil
+	}
+
+	parsed, err := parseZendeskTime(s)
+	if err != nil {
+		return err
+	}
+	*t = ZendeskTime{parsed}
+	return nil
+}
+
+func parseZendeskTime(s string) (time.Time, error) {
+	for _, layout := range zendeskTimeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown timestamp format: %q", s)
+}
@@ -0,0 +1,13 @@
+package zendesk
+
+import "time"
+
+// TimeFilter is options to filter list results by creation and update time.
+// It's embedded in list options and encoded as RFC3339 query parameters.
+// Zero times are omitted.
+type TimeFilter struct {
+	CreatedBefore time.Time `url:"created_before,omitempty"`
+	CreatedAfter  time.Time `url:"created_after,omitempty"`
+	UpdatedBefore time.Time `url:"updated_before,omitempty"`
+	UpdatedAfter  time.Time `url:"updated_after,omitempty"`
+}
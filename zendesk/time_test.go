@@ -0,0 +1,61 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestZendeskTimeUnmarshalJSON(t *testing.T) {
+	expected := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	for _, data := range []string{
+		`"2021-03-04T05:06:07Z"`,
+		`"2021-03-04T14:06:07+09:00"`,
+		`"2021-03-04T05:06:07"`,
+		`"2021-03-04 05:06:07 +0000"`,
+		`"2021-03-04 05:06:07 UTC"`,
+		`"2021-03-04 05:06:07"`,
+		`"2021/03/04 05:06:07 +0000"`,
+	} {
+		var zt ZendeskTime
+		if err := json.Unmarshal([]byte(data), &zt); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %s", data, err)
+		}
+		if !zt.Equal(expected) {
+			t.Fatalf("%s was unmarshaled to %s", data, zt)
+		}
+	}
+}
+
+func TestZendeskTimeUnmarshalJSONEmpty(t *testing.T) {
+	for _, data := range []string{`null`, `""`} {
+		zt := ZendeskTime{time.Now()}
+		if err := json.Unmarshal([]byte(data), &zt); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %s", data, err)
+		}
+		if !zt.IsZero() {
+			t.Fatalf("%s was unmarshaled to %s", data, zt)
+		}
+	}
+}
+
+func TestZendeskTimeUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{`"yesterday"`, `"2021-03-04 05:06:07 PST"`, `"2021-03-04 05:06:07 CEST"`} {
+		var zt ZendeskTime
+		if err := json.Unmarshal([]byte(data), &zt); err == nil {
+			t.Fatalf("expected an error for %s, but got %s", data, zt)
+		}
+	}
+}
+
+func TestZendeskTimeMarshalJSON(t *testing.T) {
+	zt := ZendeskTime{time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)}
+	data, err := json.Marshal(zt)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %s", err)
+	}
+	if string(data) != `"2021-03-04T05:06:07Z"` {
+		t.Fatalf("unexpected JSON %s", data)
+	}
+}
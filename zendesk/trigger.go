@@ -38,6 +38,7 @@ type Trigger struct {
 	} `json:"conditions"`
 	Actions     []TriggerAction `json:"actions"`
 	Description string          `json:"description,omitempty"`
+	CategoryID  string          `json:"category_id,omitempty"`
 	CreatedAt   *time.Time      `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time      `json:"updated_at,omitempty"`
 }
@@ -47,9 +48,10 @@ type Trigger struct {
 // ref: https://developer.zendesk.com/rest_api/docs/support/triggers#list-triggers
 type TriggerListOptions struct {
 	PageOptions
-	Active    bool   `url:"active,omitempty"`
-	SortBy    string `url:"sort_by,omitempty"`
-	SortOrder string `url:"sort_order,omitempty"`
+	Active     bool   `url:"active,omitempty"`
+	CategoryID string `url:"category_id,omitempty"`
+	SortBy     string `url:"sort_by,omitempty"`
+	SortOrder  string `url:"sort_order,omitempty"`
 }
 
 // TriggerAPI an interface containing all trigger related methods
@@ -59,6 +61,8 @@ type TriggerAPI interface {
 	GetTrigger(ctx context.Context, id int64) (Trigger, error)
 	UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (Trigger, error)
 	DeleteTrigger(ctx context.Context, id int64) error
+	SyncTriggerPositions(ctx context.Context, orderedIDs []int64) error
+	GetTriggerDefinitions(ctx context.Context) (TriggerDefinitions, error)
 }
 
 // GetTriggers fetch trigger list
@@ -76,17 +80,17 @@ func (z *Client) GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]T
 
 	u, err := addOptions("/triggers.json", opts)
 	if err != nil {
-		return []Trigger{}, Page{}, err
+		return []Trigger{}, Page{}, fmt.Errorf("get triggers: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return []Trigger{}, Page{}, err
+		return []Trigger{}, Page{}, fmt.Errorf("get triggers: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return []Trigger{}, Page{}, err
+		return []Trigger{}, Page{}, fmt.Errorf("get triggers: %w", err)
 	}
 	return data.Triggers, data.Page, nil
 }
@@ -102,12 +106,12 @@ func (z *Client) CreateTrigger(ctx context.Context, trigger Trigger) (Trigger, e
 
 	body, err := z.post(ctx, "/triggers.json", data)
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("create trigger: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("create trigger: %w", err)
 	}
 	return result.Trigger, nil
 }
@@ -122,12 +126,12 @@ func (z *Client) GetTrigger(ctx context.Context, id int64) (Trigger, error) {
 
 	body, err := z.get(ctx, fmt.Sprintf("/triggers/%d.json", id))
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("get trigger %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("get trigger %d: %w", id, err)
 	}
 	return result.Trigger, nil
 }
@@ -143,12 +147,12 @@ func (z *Client) UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (
 	data.Trigger = trigger
 	body, err := z.put(ctx, fmt.Sprintf("/triggers/%d.json", id), data)
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("update trigger %d: %w", id, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return Trigger{}, err
+		return Trigger{}, fmt.Errorf("update trigger %d: %w", id, err)
 	}
 
 	return result.Trigger, nil
@@ -160,8 +164,38 @@ func (z *Client) UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (
 func (z *Client) DeleteTrigger(ctx context.Context, id int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/triggers/%d.json", id))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete trigger %d: %w", id, err)
 	}
 
 	return nil
 }
+
+// SyncTriggerPositions sets the order of all triggers in one request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#update-many-triggers
+func (z *Client) SyncTriggerPositions(ctx context.Context, orderedIDs []int64) error {
+	if err := z.syncPositions(ctx, "triggers", orderedIDs); err != nil {
+		return fmt.Errorf("sync trigger positions: %w", err)
+	}
+	return nil
+}
+
+// GetTriggerDefinitions gets the actions and conditions available to triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-action-and-condition-definitions
+func (z *Client) GetTriggerDefinitions(ctx context.Context) (TriggerDefinitions, error) {
+	var result struct {
+		Definitions TriggerDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/triggers/definitions.json")
+	if err != nil {
+		return TriggerDefinitions{}, fmt.Errorf("get trigger definitions: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerDefinitions{}, fmt.Errorf("get trigger definitions: %w", err)
+	}
+	return result.Definitions, nil
+}
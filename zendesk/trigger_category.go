@@ -0,0 +1,143 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TriggerCategory is a category which organizes triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/
+type TriggerCategory struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	Position  int64      `json:"position,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TriggerCategoryListOptions is options for GetTriggerCategories
+type TriggerCategoryListOptions struct {
+	CursorPaginationOptions
+
+	// Sort can take "position", "name", "created_at" or "updated_at", prefixed with "-" for descending order
+	Sort string `url:"sort,omitempty"`
+}
+
+// TriggerCategoryAPI an interface containing all trigger category related methods
+type TriggerCategoryAPI interface {
+	GetTriggerCategories(ctx context.Context, opts *TriggerCategoryListOptions) ([]TriggerCategory, CursorPagination, error)
+	GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error)
+	CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error)
+	UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error)
+	DeleteTriggerCategory(ctx context.Context, id string) error
+}
+
+// GetTriggerCategories gets trigger categories.
+// Use TriggerListOptions.CategoryID to get the triggers of a category.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#list-trigger-categories
+func (z *Client) GetTriggerCategories(ctx context.Context, opts *TriggerCategoryListOptions) ([]TriggerCategory, CursorPagination, error) {
+	var data struct {
+		TriggerCategories []TriggerCategory `json:"trigger_categories"`
+		Meta              CursorPagination  `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TriggerCategoryListOptions{}
+	}
+
+	u, err := addOptions("/trigger_categories", tmp)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get trigger categories: %w", err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get trigger categories: %w", err)
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get trigger categories: %w", err)
+	}
+	return data.TriggerCategories, data.Meta, nil
+}
+
+// GetTriggerCategory gets the specified trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#show-trigger-category
+func (z *Client) GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error) {
+	var result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/trigger_categories/%s", id))
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("get trigger category %s: %w", id, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("get trigger category %s: %w", id, err)
+	}
+	return result.TriggerCategory, nil
+}
+
+// CreateTriggerCategory creates a new trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-trigger-category
+func (z *Client) CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.post(ctx, "/trigger_categories", data)
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("create trigger category: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("create trigger category: %w", err)
+	}
+	return result.TriggerCategory, nil
+}
+
+// UpdateTriggerCategory updates the specified trigger category.
+// Zendesk updates trigger categories with PATCH, so only the fields which are set are changed.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#update-trigger-category
+func (z *Client) UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.patch(ctx, fmt.Sprintf("/trigger_categories/%s", id), data)
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("update trigger category %s: %w", id, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, fmt.Errorf("update trigger category %s: %w", id, err)
+	}
+	return result.TriggerCategory, nil
+}
+
+// DeleteTriggerCategory deletes the specified trigger category.
+// Zendesk refuses to delete a category which still has triggers.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#delete-trigger-category
+func (z *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	err := z.delete(ctx, fmt.Sprintf("/trigger_categories/%s", id))
+	if err != nil {
+		return fmt.Errorf("delete trigger category %s: %w", id, err)
+	}
+	return nil
+}
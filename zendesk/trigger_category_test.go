@@ -0,0 +1,77 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTriggerCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, meta, err := client.GetTriggerCategories(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get trigger categories: %s", err)
+	}
+
+	if len(categories) != 2 || categories[1].Name != "Routing" {
+		t.Fatalf("Unexpected trigger categories %v", categories)
+	}
+
+	if meta.HasMore {
+		t.Fatalf("Unexpected meta %v", meta)
+	}
+}
+
+func TestCreateTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "trigger_category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.CreateTriggerCategory(ctx, TriggerCategory{Name: "Escalations", Position: 2})
+	if err != nil {
+		t.Fatalf("Failed to create trigger category: %s", err)
+	}
+
+	if category.ID != "10028" {
+		t.Fatalf("Unexpected trigger category %v", category)
+	}
+}
+
+func TestUpdateTriggerCategory(t *testing.T) {
+	var method string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		w.Write(readFixture(filepath.Join(http.MethodPatch, "trigger_category.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.UpdateTriggerCategory(ctx, "10026", TriggerCategory{Name: "Customer notifications"})
+	if err != nil {
+		t.Fatalf("Failed to update trigger category: %s", err)
+	}
+
+	if method != http.MethodPatch {
+		t.Fatalf("expected method %s, but got %s", http.MethodPatch, method)
+	}
+
+	if category.Name != "Customer notifications" {
+		t.Fatalf("Unexpected trigger category %v", category)
+	}
+}
+
+func TestDeleteTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTriggerCategory(ctx, "10026"); err != nil {
+		t.Fatalf("Failed to delete trigger category: %s", err)
+	}
+}
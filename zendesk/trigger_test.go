@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -130,3 +131,42 @@ func TestDeleteTriggerFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetTriggersByCategory(t *testing.T) {
+	var category string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		category = r.URL.Query().Get("category_id")
+		w.Write(readFixture(filepath.Join(http.MethodGet, "triggers.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetTriggers(ctx, &TriggerListOptions{CategoryID: "10026"})
+	if err != nil {
+		t.Fatalf("Failed to get triggers: %s", err)
+	}
+
+	if category != "10026" {
+		t.Fatalf("expected category_id 10026, but got %q", category)
+	}
+}
+
+func TestGetTriggerDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetTriggerDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get trigger definitions: %s", err)
+	}
+
+	if len(definitions.Actions) != 1 || len(definitions.ConditionsAll) != 1 || len(definitions.ConditionsAny) != 1 {
+		t.Fatalf("unexpected definitions %+v", definitions)
+	}
+
+	operators := definitions.ConditionsAll[0].Operators
+	if len(operators) != 2 || operators[1].Value != "changed" || !operators[1].Terminal {
+		t.Fatalf("unexpected operators %+v", operators)
+	}
+}
@@ -74,9 +74,14 @@ var userRoleText = map[int]string{
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#list-users
 type UserListOptions struct {
 	PageOptions
-	Role          string   `url:"role,omitempty"`
-	Roles         []string `url:"role[],omitempty"`
-	PermissionSet int64    `url:"permission_set,omitempty"`
+
+	// Role filters users by one role, and Roles by any of several roles.
+	// Roles are "end-user", "agent" or "admin", see UserRoleText.
+	Role  string   `url:"role,omitempty"`
+	Roles []string `url:"role[],omitempty"`
+
+	// PermissionSet filters agents by the ID of their custom role
+	PermissionSet int64 `url:"permission_set,omitempty"`
 }
 
 // UserRoleText takes role type and returns role name string
@@ -116,10 +121,17 @@ type UserAPI interface {
 	GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([]User, Page, error)
 	GetUsers(ctx context.Context, opts *UserListOptions) ([]User, Page, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
+	GetCurrentUser(ctx context.Context) (User, error)
 	CreateUser(ctx context.Context, user User) (User, error)
 	CreateOrUpdateUser(ctx context.Context, user User) (User, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
+	SuspendUser(ctx context.Context, userID int64) (User, error)
+	UnsuspendUser(ctx context.Context, userID int64) (User, error)
 	GetUserRelated(ctx context.Context, userID int64) (UserRelated, error)
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	GetDeletedUsers(ctx context.Context, opts *PageOptions) ([]User, Page, error)
+	GetDeletedUser(ctx context.Context, userID int64) (User, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error)
 }
 
 // GetUsers fetch user list
@@ -137,17 +149,17 @@ func (z *Client) GetUsers(ctx context.Context, opts *UserListOptions) ([]User, P
 
 	u, err := addOptions("/users.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get users: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get users: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get users: %w", err)
 	}
 	return data.Users, data.Page, nil
 }
@@ -169,17 +181,17 @@ func (z *Client) SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]U
 
 	u, err := addOptions("/users/search.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("search users: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("search users: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("search users: %w", err)
 	}
 	return data.Users, data.Page, nil
 }
@@ -201,17 +213,17 @@ func (z *Client) GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([
 
 	u, err := addOptions("/users/show_many.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get many users: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get many users: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get many users: %w", err)
 	}
 	return data.Users, data.Page, nil
 }
@@ -228,12 +240,12 @@ func (z *Client) CreateUser(ctx context.Context, user User) (User, error) {
 
 	body, err := z.post(ctx, "/users.json", data)
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("create user: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("create user: %w", err)
 	}
 	return result.User, nil
 }
@@ -248,12 +260,12 @@ func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error
 
 	body, err := z.post(ctx, "/users/create_or_update.json", data)
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("create or update user: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("create or update user: %w", err)
 	}
 	return result.User, nil
 }
@@ -269,12 +281,32 @@ func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
 
 	body, err := z.get(ctx, fmt.Sprintf("/users/%d.json", userID))
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("get user %d: %w", userID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return User{}, err
+		return User{}, fmt.Errorf("get user %d: %w", userID, err)
+	}
+	return result.User, nil
+}
+
+// GetCurrentUser gets the user whom the credential belongs to.
+// Zendesk returns an anonymous user with ID 0 when the request is not authenticated.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-the-currently-authenticated-user
+func (z *Client) GetCurrentUser(ctx context.Context) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.get(ctx, "/users/me.json")
+	if err != nil {
+		return User{}, fmt.Errorf("get current user: %w", err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, fmt.Errorf("get current user: %w", err)
 	}
 	return result.User, nil
 }
@@ -287,6 +319,54 @@ func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User,
 	}
 	data.User = user
 
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+	if err != nil {
+		return User{}, fmt.Errorf("update user %d: %w", userID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, fmt.Errorf("update user %d: %w", userID, err)
+	}
+	return result.User, nil
+}
+
+// SuspendUser suspends the specified user, who can't sign in until unsuspended
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#suspending-a-user
+func (z *Client) SuspendUser(ctx context.Context, userID int64) (User, error) {
+	user, err := z.setUserSuspended(ctx, userID, true)
+	if err != nil {
+		return User{}, fmt.Errorf("suspend user %d: %w", userID, err)
+	}
+	return user, nil
+}
+
+// UnsuspendUser unsuspends the specified user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#suspending-a-user
+func (z *Client) UnsuspendUser(ctx context.Context, userID int64) (User, error) {
+	user, err := z.setUserSuspended(ctx, userID, false)
+	if err != nil {
+		return User{}, fmt.Errorf("unsuspend user %d: %w", userID, err)
+	}
+	return user, nil
+}
+
+// setUserSuspended updates only the suspended flag of the user.
+// User.Suspended is omitted when false, so UpdateUser can't unsuspend a user.
+func (z *Client) setUserSuspended(ctx context.Context, userID int64, suspended bool) (User, error) {
+	var data struct {
+		User struct {
+			Suspended bool `json:"suspended"`
+		} `json:"user"`
+	}
+	data.User.Suspended = suspended
+
+	var result struct {
+		User User `json:"user"`
+	}
+
 	body, err := z.put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
 	if err != nil {
 		return User{}, err
@@ -308,11 +388,11 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	body, err := z.get(ctx, fmt.Sprintf("/users/%d/related.json", userID))
 	if err != nil {
-		return UserRelated{}, err
+		return UserRelated{}, fmt.Errorf("get user related %d: %w", userID, err)
 	}
 
 	if err := json.Unmarshal(body, &data); err != nil {
-		return UserRelated{}, err
+		return UserRelated{}, fmt.Errorf("get user related %d: %w", userID, err)
 	}
 
 	return data.UserRelated, nil
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -32,6 +33,7 @@ type UserFieldListOptions struct {
 
 type UserFieldAPI interface {
 	GetUserFields(ctx context.Context, opts *UserFieldListOptions) ([]UserField, Page, error)
+	ReorderUserFields(ctx context.Context, orderedIDs []int64) error
 }
 
 // GetUserFields fetch trigger list
@@ -50,17 +52,27 @@ func (z *Client) GetUserFields(ctx context.Context, opts *UserFieldListOptions)
 
 	u, err := addOptions("/user_fields.json", tmp)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get user fields: %w", err)
 	}
 
 	body, err := z.get(ctx, u)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get user fields: %w", err)
 	}
 
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return nil, Page{}, err
+		return nil, Page{}, fmt.Errorf("get user fields: %w", err)
 	}
 	return data.UserFields, data.Page, nil
 }
+
+// ReorderUserFields sets the order of all user fields in one request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#reorder-user-field
+func (z *Client) ReorderUserFields(ctx context.Context, orderedIDs []int64) error {
+	if err := z.reorderFields(ctx, "user_field", orderedIDs); err != nil {
+		return fmt.Errorf("reorder user fields: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package zendesk
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -55,3 +56,26 @@ func TestUserFieldQueryParamsSet(t *testing.T) {
 		t.Fatalf("Received error calling API: %v", err)
 	}
 }
+
+func TestReorderUserFields(t *testing.T) {
+	var payload string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/user_fields/reorder.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		payload = string(body)
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.ReorderUserFields(ctx, []int64{3, 1, 2}); err != nil {
+		t.Fatalf("Failed to reorder user fields: %s", err)
+	}
+
+	expected := `{"user_field_ids":[3,1,2]}`
+	if payload != expected {
+		t.Fatalf("expected payload %s, but got %s", expected, payload)
+	}
+}
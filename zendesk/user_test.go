@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
 	"testing"
 )
@@ -192,6 +195,35 @@ func TestUpdateUser(t *testing.T) {
 	}
 }
 
+func TestSuspendUser(t *testing.T) {
+	var payload map[string]map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.SuspendUser(ctx, 369531345753); err != nil {
+		t.Fatalf("Failed to suspend user: %s", err)
+	}
+
+	if payload["user"]["suspended"] != true {
+		t.Fatalf("Unexpected payload %v", payload)
+	}
+
+	if _, err := client.UnsuspendUser(ctx, 369531345753); err != nil {
+		t.Fatalf("Failed to unsuspend user: %s", err)
+	}
+
+	if v, ok := payload["user"]["suspended"]; !ok || v != false {
+		t.Fatalf("suspended should be sent as false: %v", payload)
+	}
+}
+
 func TestUpdateUserFailure(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPut, "user.json", http.StatusInternalServerError)
 	client := newTestClient(mockAPI)
@@ -218,3 +250,59 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestGetUsersByPermissionSet(t *testing.T) {
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetUsers(ctx, &UserListOptions{
+		Role:          UserRoleText(UserRoleAgent),
+		PermissionSet: 360000123456,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get users: %s", err)
+	}
+
+	if query.Get("role") != "agent" || query.Get("permission_set") != "360000123456" {
+		t.Fatalf("Unexpected query %s", query.Encode())
+	}
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	var path string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current user: %s", err)
+	}
+
+	if path != "/users/me.json" {
+		t.Fatalf("Unexpected request path %s", path)
+	}
+
+	if user.ID != 369531345753 {
+		t.Fatalf("Unexpected user %v", user)
+	}
+}
+
+func TestGetCurrentUserUnauthorized(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "user.json", http.StatusUnauthorized)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetCurrentUser(ctx)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected %s, but got %v", ErrUnauthorized, err)
+	}
+}
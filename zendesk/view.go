@@ -29,6 +29,7 @@ type (
 		GetView(context.Context, int64) (View, error)
 		GetViews(context.Context) ([]View, Page, error)
 		GetTicketsFromView(context.Context, int64) ([]Ticket, error)
+		SyncViewPositions(ctx context.Context, orderedIDs []int64) error
 	}
 )
 
@@ -43,11 +44,11 @@ func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
 	body, err := z.get(ctx, "/views.json")
 
 	if err != nil {
-		return []View{}, Page{}, err
+		return []View{}, Page{}, fmt.Errorf("get views: %w", err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return []View{}, Page{}, err
+		return []View{}, Page{}, fmt.Errorf("get views: %w", err)
 	}
 
 	return result.Views, result.Page, nil
@@ -63,11 +64,11 @@ func (z *Client) GetView(ctx context.Context, viewID int64) (View, error) {
 	body, err := z.get(ctx, fmt.Sprintf("/views/%d.json", viewID))
 
 	if err != nil {
-		return View{}, err
+		return View{}, fmt.Errorf("get view %d: %w", viewID, err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return View{}, err
+		return View{}, fmt.Errorf("get view %d: %w", viewID, err)
 	}
 
 	return result.View, nil
@@ -83,12 +84,21 @@ func (z *Client) GetTicketsFromView(ctx context.Context, viewID int64) ([]Ticket
 	body, err := z.get(ctx, fmt.Sprintf("/views/%d/tickets.json", viewID))
 
 	if err != nil {
-		return []Ticket{}, err
+		return []Ticket{}, fmt.Errorf("get tickets from view %d: %w", viewID, err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return []Ticket{}, err
+		return []Ticket{}, fmt.Errorf("get tickets from view %d: %w", viewID, err)
 	}
 
 	return result.Tickets, nil
 }
+
+// SyncViewPositions sets the order of all views in one request.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-many-views
+func (z *Client) SyncViewPositions(ctx context.Context, orderedIDs []int64) error {
+	if err := z.syncPositions(ctx, "views", orderedIDs); err != nil {
+		return fmt.Errorf("sync view positions: %w", err)
+	}
+	return nil
+}
@@ -38,11 +38,54 @@ type WebhookSigningSecret struct {
 	Secret    string `json:"secret"`
 }
 
+// WebhookInvocation is a delivery of an event to a webhook, which may take several attempts.
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhook-invocations
+type WebhookInvocation struct {
+	ID                         string     `json:"id"`
+	LatestCompletionStatus     string     `json:"latest_completion_status"`
+	LatestCompletionStatusCode int        `json:"latest_completion_status_code,omitempty"`
+	LatestCompletionTimestamp  *time.Time `json:"latest_completion_timestamp,omitempty"`
+	SourceEvent                struct {
+		ID               string `json:"id"`
+		Subscription     string `json:"subscription"`
+		SubscriptionType string `json:"subscription_type,omitempty"`
+	} `json:"source_event"`
+}
+
+// WebhookInvocationAttempt is one attempt to deliver an invocation to the webhook endpoint
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhook-invocation-attempts
+type WebhookInvocationAttempt struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Response    struct {
+		StatusCode int    `json:"status_code"`
+		Body       string `json:"body,omitempty"`
+	} `json:"response"`
+}
+
+// WebhookInvocationListOptions is options for GetWebhookInvocations
+type WebhookInvocationListOptions struct {
+	CursorPaginationOptions
+
+	// Status can take "success", "failed" or "circuit_broken"
+	Status string    `url:"filter[status],omitempty"`
+	From   time.Time `url:"filter[from_ts],omitempty"`
+	To     time.Time `url:"filter[to_ts],omitempty"`
+
+	// Sort can take "latest_completion_timestamp" or "-latest_completion_timestamp"
+	Sort string `url:"sort,omitempty"`
+}
+
 type WebhookAPI interface {
 	CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, error)
 	GetWebhook(ctx context.Context, webhookID string) (*Webhook, error)
 	UpdateWebhook(ctx context.Context, webhookID string, hook *Webhook) error
 	DeleteWebhook(ctx context.Context, webhookID string) error
+	GetWebhookInvocations(ctx context.Context, webhookID string, opts *WebhookInvocationListOptions) ([]WebhookInvocation, CursorPagination, error)
+	GetWebhookInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]WebhookInvocationAttempt, error)
 }
 
 // CreateWebhook creates new webhook.
@@ -56,12 +99,12 @@ func (z *Client) CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, er
 
 	body, err := z.post(ctx, "/webhooks", data)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("create webhook: %w", err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("create webhook: %w", err)
 	}
 	return result.Webhook, nil
 }
@@ -76,12 +119,12 @@ func (z *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, er
 
 	body, err := z.get(ctx, fmt.Sprintf("/webhooks/%s", webhookID))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get webhook %s: %w", webhookID, err)
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get webhook %s: %w", webhookID, err)
 	}
 
 	return result.Webhook, nil
@@ -98,7 +141,7 @@ func (z *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *Webh
 
 	_, err := z.put(ctx, fmt.Sprintf("/webhooks/%s", webhookID), data)
 	if err != nil {
-		return err
+		return fmt.Errorf("update webhook %s: %w", webhookID, err)
 	}
 
 	return nil
@@ -110,8 +153,59 @@ func (z *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *Webh
 func (z *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 	err := z.delete(ctx, fmt.Sprintf("/webhooks/%s", webhookID))
 	if err != nil {
-		return err
+		return fmt.Errorf("delete webhook %s: %w", webhookID, err)
 	}
 
 	return nil
 }
+
+// GetWebhookInvocations gets the recent invocations of the specified webhook
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhook-invocations
+func (z *Client) GetWebhookInvocations(ctx context.Context, webhookID string, opts *WebhookInvocationListOptions) ([]WebhookInvocation, CursorPagination, error) {
+	var result struct {
+		Invocations []WebhookInvocation `json:"invocations"`
+		Meta        CursorPagination    `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &WebhookInvocationListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/webhooks/%s/invocations", webhookID), tmp)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get webhook invocations %s: %w", webhookID, err)
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get webhook invocations %s: %w", webhookID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPagination{}, fmt.Errorf("get webhook invocations %s: %w", webhookID, err)
+	}
+	return result.Invocations, result.Meta, nil
+}
+
+// GetWebhookInvocationAttempts gets the delivery attempts of the specified invocation
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhook-invocation-attempts
+func (z *Client) GetWebhookInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]WebhookInvocationAttempt, error) {
+	var result struct {
+		Attempts []WebhookInvocationAttempt `json:"attempts"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/webhooks/%s/invocations/%s/attempts", webhookID, invocationID))
+	if err != nil {
+		return nil, fmt.Errorf("get webhook invocation attempts %s: %w", invocationID, err)
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook invocation attempts %s: %w", invocationID, err)
+	}
+	return result.Attempts, nil
+}
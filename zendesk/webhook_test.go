@@ -80,3 +80,51 @@ func TestDeleteWebhook(t *testing.T) {
 		t.Fatalf("Failed to delete webhook: %s", err)
 	}
 }
+
+func TestGetWebhookInvocations(t *testing.T) {
+	var query string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("filter[status]")
+		w.Write(readFixture("GET/webhook_invocations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	invocations, meta, err := client.GetWebhookInvocations(context.Background(), "01EJFTSCC78X5V07NPY2MHR00M", &WebhookInvocationListOptions{
+		Status: "failed",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get webhook invocations: %s", err)
+	}
+
+	if query != "failed" {
+		t.Fatalf("Unexpected status filter %q", query)
+	}
+
+	if len(invocations) != 2 {
+		t.Fatalf("expected length of invocations is 2, but got %d", len(invocations))
+	}
+
+	if invocations[0].LatestCompletionStatusCode != 500 || invocations[0].LatestCompletionTimestamp == nil {
+		t.Fatalf("Unexpected invocation %v", invocations[0])
+	}
+
+	if !meta.HasMore || meta.AfterCursor != "bz0y" {
+		t.Fatalf("Unexpected meta %v", meta)
+	}
+}
+
+func TestGetWebhookInvocationAttempts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "webhook_invocation_attempts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attempts, err := client.GetWebhookInvocationAttempts(context.Background(), "01EJFTSCC78X5V07NPY2MHR00M", "01FN9X2Q3R7VY4J3HTFQJ2W7KF")
+	if err != nil {
+		t.Fatalf("Failed to get webhook invocation attempts: %s", err)
+	}
+
+	if len(attempts) != 1 || attempts[0].Response.StatusCode != 500 || attempts[0].Response.Body != "internal server error" {
+		t.Fatalf("Unexpected attempts %v", attempts)
+	}
+}
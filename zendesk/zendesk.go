@@ -9,12 +9,15 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
 	baseURLFormat = "https://%s.zendesk.com/api/v2"
+	hostURLFormat = "https://%s/api/v2"
 )
 
 var defaultHeaders = map[string]string{
@@ -31,6 +34,26 @@ type (
 		httpClient *http.Client
 		credential Credential
 		headers    map[string]string
+		limiter    *rateLimiter
+
+		exportTimeout time.Duration
+
+		ticketFieldMu  sync.Mutex
+		ticketFieldIDs map[string]int64
+
+		requestHook  func(*http.Request)
+		responseHook func(*http.Response, time.Duration)
+
+		maxRetries  int
+		shouldRetry func(*http.Response, error) bool
+
+		cache *responseCache
+
+		logger Logger
+
+		reads *readGroup
+
+		dryRun *dryRun
 	}
 
 	// BaseAPI encapsulates base methods for zendesk client
@@ -75,6 +98,23 @@ func (z *Client) SetSubdomain(subdomain string) error {
 	return nil
 }
 
+// SetHost saves the host of the account in client, such as "example.zendesk.com"
+// or a host mapped domain like "support.example.eu". Use it instead of SetSubdomain
+// when the account isn't reached at {subdomain}.zendesk.com.
+// Requests are sent to https://{host}/api/v2.
+func (z *Client) SetHost(host string) error {
+	baseURL, err := url.Parse(fmt.Sprintf(hostURLFormat, host))
+	if err != nil {
+		return err
+	}
+	if host == "" || baseURL.Host != host {
+		return fmt.Errorf("%s is invalid host", host)
+	}
+
+	z.baseURL = baseURL
+	return nil
+}
+
 // SetEndpointURL replace full URL of endpoint without subdomain validation.
 // This is mainly used for testing to point to mock API server.
 func (z *Client) SetEndpointURL(newURL string) error {
@@ -93,8 +133,69 @@ func (z *Client) SetCredential(cred Credential) {
 	z.credential = cred
 }
 
+// SetProxy routes all requests through the proxy at proxyURL.
+// It works on a copy of the HTTP client's transport, so the *http.Client
+// passed to NewClient and http.DefaultTransport are not modified.
+// For other settings such as custom root CAs, pass an *http.Client with
+// a configured Transport to NewClient instead.
+func (z *Client) SetProxy(proxyURL *url.URL) error {
+	var transport *http.Transport
+	switch t := z.httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return fmt.Errorf("proxy can't be set to transport of type %T", t)
+	}
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	httpClient := *z.httpClient
+	httpClient.Transport = transport
+	z.httpClient = &httpClient
+	return nil
+}
+
+// SetRateLimit limits the number of requests sent by the client per minute.
+// Requests are spread evenly and wait for their turn until it comes or the
+// context is done. Zendesk's rate limit is account-wide, so this prevents
+// a busy client from bursting into 429 responses.
+// A value less than or equal to 0 removes the limit.
+func (z *Client) SetRateLimit(requestsPerMinute int) {
+	if requestsPerMinute <= 0 {
+		z.limiter = nil
+		return
+	}
+
+	z.limiter = newRateLimiter(requestsPerMinute)
+}
+
+// SetRequestHook saves a function which is called with every request
+// right before it is sent. It can be used to record metrics.
+// A panic in the hook is recovered and does not affect the request.
+func (z *Client) SetRequestHook(hook func(*http.Request)) {
+	z.requestHook = hook
+}
+
+// SetResponseHook saves a function which is called after every request
+// with the response and how long the request took. The response is nil
+// when the request failed before a response was received.
+// The hook must not read or close the response body.
+// A panic in the hook is recovered and does not affect the request.
+func (z *Client) SetResponseHook(hook func(*http.Response, time.Duration)) {
+	z.responseHook = hook
+}
+
 // get get JSON data from API and returns its body as []bytes
 func (z *Client) get(ctx context.Context, path string) ([]byte, error) {
+	if z.reads != nil && coalescable(ctx) {
+		return z.getShared(ctx, path)
+	}
+	return z.getOnce(ctx, path)
+}
+
+// getOnce sends a GET request for path
+func (z *Client) getOnce(ctx context.Context, path string) ([]byte, error) {
 	req, err := http.NewRequest(http.MethodGet, z.baseURL.String()+path, nil)
 	if err != nil {
 		return nil, err
@@ -102,7 +203,7 @@ func (z *Client) get(ctx context.Context, path string) ([]byte, error) {
 
 	req = z.prepareRequest(ctx, req)
 
-	resp, err := z.httpClient.Do(req)
+	resp, err := z.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +237,7 @@ func (z *Client) post(ctx context.Context, path string, data interface{}) ([]byt
 
 	req = z.prepareRequest(ctx, req)
 
-	resp, err := z.httpClient.Do(req)
+	resp, err := z.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +255,9 @@ func (z *Client) post(ctx context.Context, path string, data interface{}) ([]byt
 		}
 	}
 
+	z.invalidateCache(path)
+	saveWarnings(ctx, body)
+
 	return body, nil
 }
 
@@ -171,7 +275,7 @@ func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte
 
 	req = z.prepareRequest(ctx, req)
 
-	resp, err := z.httpClient.Do(req)
+	resp, err := z.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +294,47 @@ func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte
 		}
 	}
 
+	z.invalidateCache(path)
+	saveWarnings(ctx, body)
+
+	return body, nil
+}
+
+// patch sends data to API and returns response body as []bytes
+func (z *Client) patch(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, z.baseURL.String()+path, strings.NewReader(string(bytes)))
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+
+	resp, err := z.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	z.invalidateCache(path)
+	saveWarnings(ctx, body)
+
 	return body, nil
 }
 
@@ -202,7 +347,7 @@ func (z *Client) delete(ctx context.Context, path string) error {
 
 	req = z.prepareRequest(ctx, req)
 
-	resp, err := z.httpClient.Do(req)
+	resp, err := z.do(req)
 	if err != nil {
 		return err
 	}
@@ -220,13 +365,47 @@ func (z *Client) delete(ctx context.Context, path string) error {
 		}
 	}
 
+	z.invalidateCache(path)
 	return nil
 }
 
+// deleteWithBody sends a delete request to API which responds with the deleted
+// resource and returns response body as []bytes
+func (z *Client) deleteWithBody(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, z.baseURL.String()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.prepareRequest(context.WithValue(ctx, deleteWithBodyKey{}, true), req)
+
+	resp, err := z.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	z.invalidateCache(path)
+	return body, nil
+}
+
 // prepare request sets common request variables such as authn and user agent
 func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
 	out := req.WithContext(ctx)
 	z.includeHeaders(out)
+	setRequestOptions(out)
 	if z.credential != nil {
 		out.SetBasicAuth(z.credential.Email(), z.credential.Secret())
 	}
@@ -234,6 +413,77 @@ func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Re
 	return out
 }
 
+// do sends an HTTP request once the rate limiter allows it, retries it
+// as configured by SetRetry and reports it to the request and response hooks
+// and WithResponse
+func (z *Client) do(req *http.Request) (*http.Response, error) {
+	if z.dryRun.skips(req) {
+		resp := z.dryRun.respond(z, req)
+		saveResponse(req.Context(), resp, 0, 0)
+		return resp, nil
+	}
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		resp, err := z.doOnce(req)
+		if attempt >= z.maxRetries || !z.shouldRetry(resp, err) || !rewindBody(req) {
+			saveResponse(req.Context(), resp, attempt, time.Since(start))
+			return resp, err
+		}
+
+		delay := retryDelay(resp, attempt)
+		z.logRetryWait(req, resp, err, attempt, delay)
+		discardResponse(resp)
+
+		select {
+		case <-req.Context().Done():
+			saveResponse(req.Context(), nil, attempt, time.Since(start))
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// doOnce sends an HTTP request once the rate limiter allows it
+func (z *Client) doOnce(req *http.Request) (*http.Response, error) {
+	if z.limiter != nil {
+		wait, err := z.limiter.wait(req.Context())
+		if wait > 0 {
+			z.logRateLimitWait(req, wait)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	z.callRequestHook(req)
+	start := time.Now()
+	resp, err := z.httpClientFor(req).Do(req)
+	z.callResponseHook(resp, time.Since(start))
+
+	return resp, err
+}
+
+// callRequestHook calls the request hook and recovers from its panic
+func (z *Client) callRequestHook(req *http.Request) {
+	if z.requestHook == nil {
+		return
+	}
+
+	defer func() { recover() }()
+	z.requestHook(req)
+}
+
+// callResponseHook calls the response hook and recovers from its panic
+func (z *Client) callResponseHook(resp *http.Response, elapsed time.Duration) {
+	if z.responseHook == nil {
+		return
+	}
+
+	defer func() { recover() }()
+	z.responseHook(resp, elapsed)
+}
+
 // includeHeaders set HTTP headers from client.headers to *http.Request
 func (z *Client) includeHeaders(req *http.Request) {
 	for key, value := range z.headers {
@@ -5,10 +5,12 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 ////////// Helper //////////
@@ -88,6 +90,26 @@ func TestSetSubdomainFail(t *testing.T) {
 	}
 }
 
+func TestSetHost(t *testing.T) {
+	client, _ := NewClient(nil)
+	if err := client.SetHost("support.example.eu"); err != nil {
+		t.Fatalf("SetHost should success: %s", err)
+	}
+
+	if u := client.baseURL.String(); u != "https://support.example.eu/api/v2" {
+		t.Fatalf("unexpected base URL %s", u)
+	}
+}
+
+func TestSetHostFail(t *testing.T) {
+	client, _ := NewClient(nil)
+	for _, host := range []string{"", "https://example.zendesk.com", "example.zendesk.com/api/v2"} {
+		if err := client.SetHost(host); err == nil {
+			t.Fatalf("SetHost should fail with %q", host)
+		}
+	}
+}
+
 func TestSetEndpointURL(t *testing.T) {
 	client, _ := NewClient(nil)
 	if err := client.SetEndpointURL("http://127.0.0.1:3000"); err != nil {
@@ -108,6 +130,35 @@ func TestSetCredential(t *testing.T) {
 	}
 }
 
+func TestSetProxy(t *testing.T) {
+	var requestURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	defer proxy.Close()
+
+	client, _ := NewClient(nil)
+	client.SetEndpointURL("http://example.zendesk.com/api/v2")
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	if err := client.SetProxy(proxyURL); err != nil {
+		t.Fatalf("Failed to set proxy: %s", err)
+	}
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+
+	if requestURL != "http://example.zendesk.com/api/v2/groups.json" {
+		t.Fatalf("Request was not sent through the proxy: %s", requestURL)
+	}
+
+	if http.DefaultClient.Transport != nil {
+		t.Fatal("SetProxy should not modify http.DefaultClient")
+	}
+}
+
 func TestGet(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "groups.json")
 	client := newTestClient(mockAPI)
@@ -251,6 +302,50 @@ func TestDeleteFailure(t *testing.T) {
 	}
 }
 
+func TestRequestAndResponseHooks(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var path string
+	var status int
+	client.SetRequestHook(func(req *http.Request) {
+		path = req.URL.Path
+	})
+	client.SetResponseHook(func(resp *http.Response, elapsed time.Duration) {
+		status = resp.StatusCode
+	})
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+
+	if path != "/groups.json" {
+		t.Fatalf("Request hook received unexpected path %s", path)
+	}
+
+	if status != http.StatusOK {
+		t.Fatalf("Response hook received unexpected status %d", status)
+	}
+}
+
+func TestPanickingHooksAreRecovered(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRequestHook(func(*http.Request) {
+		panic("request hook")
+	})
+	client.SetResponseHook(func(*http.Response, time.Duration) {
+		panic("response hook")
+	})
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
 func TestIncludeHeaders(t *testing.T) {
 	client, _ := NewClient(nil)
 	client.headers = map[string]string{
@@ -0,0 +1,112 @@
+package zendesktest
+
+// MacroJSON is a response of GET /api/v2/macros/{macro_id}.json
+const MacroJSON = `{
+  "macro": {
+    "actions": [
+      {
+        "field": "status",
+        "value": "solved"
+      },
+      {
+        "field": "priority",
+        "value": "normal"
+      },
+      {
+        "field": "comment_value",
+        "value": "Thanks for your request. This issue is a known issue."
+      }
+    ],
+    "active": true,
+    "created_at": "2019-09-16T02:17:38Z",
+    "description": null,
+    "id": 360111062754,
+    "position": 9999,
+    "restriction": null,
+    "title": "Close and redirect to topics",
+    "updated_at": "2019-09-16T02:17:38Z",
+    "url": "https://example.zendesk.com/api/v2/macros/360111062754.json"
+  }
+}`
+
+// TicketJSON is a response of GET /api/v2/tickets/{ticket_id}.json
+const TicketJSON = `{
+  "ticket": {
+    "url": "https://example.zendesk.com/api/v2/tickets/2.json",
+    "id": 2,
+    "external_id": null,
+    "via": {
+      "channel": "email",
+      "source": {
+        "from": {
+          "address": "customer@example.com",
+          "name": "Customer"
+        },
+        "to": {
+          "address": "support@example.zendesk.com",
+          "name": "Example Support"
+        },
+        "rel": null
+      }
+    },
+    "created_at": "2019-06-03T02:23:47Z",
+    "updated_at": "2019-06-05T01:13:24Z",
+    "type": null,
+    "subject": "Help with my order",
+    "raw_subject": "Help with my order",
+    "description": "My order has not arrived yet.",
+    "priority": "normal",
+    "status": "open",
+    "recipient": "support@example.zendesk.com",
+    "requester_id": 377922500012,
+    "submitter_id": 377922500012,
+    "assignee_id": 377922500013,
+    "organization_id": 360363695492,
+    "group_id": 360004077472,
+    "collaborator_ids": [],
+    "follower_ids": [],
+    "email_cc_ids": [],
+    "forum_topic_id": null,
+    "problem_id": null,
+    "has_incidents": false,
+    "is_public": true,
+    "due_at": null,
+    "tags": ["order"],
+    "custom_fields": [],
+    "satisfaction_rating": null,
+    "sharing_agreement_ids": [],
+    "followup_ids": [],
+    "ticket_form_id": 360000389592,
+    "brand_id": 360002256672,
+    "allow_channelback": false,
+    "allow_attachments": true
+  }
+}`
+
+// SideConversationJSON is a response of POST /api/v2/tickets/{ticket_id}/side_conversations
+const SideConversationJSON = `{
+  "side_conversation": {
+    "url": "https://example.zendesk.com/api/v2/tickets/2/side_conversations/8566255a-8a8d-11ea-9b5e-9fc4d9a6bd5c",
+    "id": "8566255a-8a8d-11ea-9b5e-9fc4d9a6bd5c",
+    "ticket_id": 2,
+    "subject": "Question about the order",
+    "preview_text": "Could you check the shipping status?",
+    "state": "open",
+    "participants": [
+      {
+        "user_id": 377922500013,
+        "name": "Agent",
+        "email": "agent@example.com"
+      },
+      {
+        "user_id": 377922500014,
+        "name": "Warehouse",
+        "email": "warehouse@example.com"
+      }
+    ],
+    "created_at": "2020-04-29T17:06:39.393Z",
+    "updated_at": "2020-04-29T17:06:39.393Z",
+    "message_added_at": "2020-04-29T17:06:39.393Z",
+    "state_updated_at": "2020-04-29T17:06:39.393Z"
+  }
+}`
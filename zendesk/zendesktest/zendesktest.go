@@ -0,0 +1,46 @@
+// Package zendesktest provides utilities for testing code which uses the zendesk client.
+//
+// NewTestClient returns a client which sends all requests to an HTTP handler,
+// and the fixture constants hold realistic Zendesk responses for that handler:
+//
+//	client := zendesktest.NewTestClient(t, zendesktest.JSONHandler(http.StatusOK, zendesktest.TicketJSON))
+//	ticket, err := client.GetTicket(ctx, 2)
+package zendesktest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lewisje1991/go-zendesk/zendesk"
+)
+
+// NewTestClient starts an httptest server with handler and returns a client
+// which sends every request to it. The server is closed when the test ends.
+func NewTestClient(t testing.TB, handler http.Handler) *zendesk.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := zendesk.NewClient(server.Client())
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	if err := client.SetEndpointURL(server.URL); err != nil {
+		t.Fatalf("failed to set endpoint: %s", err)
+	}
+	client.SetCredential(zendesk.NewAPITokenCredential("agent@example.com", "token"))
+
+	return client
+}
+
+// JSONHandler returns a handler which responds to every request with status and body
+func JSONHandler(status int, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	})
+}
@@ -0,0 +1,56 @@
+package zendesktest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/lewisje1991/go-zendesk/zendesk"
+)
+
+func TestNewTestClient(t *testing.T) {
+	var path string
+	client := NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		JSONHandler(http.StatusOK, TicketJSON).ServeHTTP(w, r)
+	}))
+
+	ticket, err := client.GetTicket(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if path != "/tickets/2.json" {
+		t.Fatalf("Unexpected request path %s", path)
+	}
+
+	if ticket.ID != 2 || ticket.Via.Channel != "email" {
+		t.Fatalf("Unexpected ticket %v", ticket)
+	}
+}
+
+func TestSideConversationJSON(t *testing.T) {
+	client := NewTestClient(t, JSONHandler(http.StatusCreated, SideConversationJSON))
+
+	sc, err := client.CreateSideConversation(context.Background(), 2, zendesk.Message{Subject: "Question about the order"})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	if sc.TicketID != 2 || len(sc.Participants) != 2 {
+		t.Fatalf("Unexpected side conversation %v", sc)
+	}
+}
+
+func TestMacroJSON(t *testing.T) {
+	client := NewTestClient(t, JSONHandler(http.StatusOK, MacroJSON))
+
+	macro, err := client.GetMacro(context.Background(), 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to get macro: %s", err)
+	}
+
+	if len(macro.Actions) != 3 || macro.Actions[0].Value != "solved" {
+		t.Fatalf("Unexpected macro %v", macro)
+	}
+}